@@ -9,6 +9,7 @@ import (
 	"github.com/rxdn/gdl/objects/guild/emoji"
 	"github.com/rxdn/gdl/objects/member"
 	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/utils"
 	"os"
 	"strconv"
 	"strings"
@@ -80,7 +81,7 @@ func (c *BoltCache) StoreUsers(users []user.User) {
 
 			for _, u := range users {
 				if encoded, err := json.Marshal(u.ToCachedUser()); err == nil {
-					if err := b.Put(toBytes(u.Id), encoded); err != nil {
+					if err := b.Put(toBytes(uint64(u.Id)), encoded); err != nil {
 						return err
 					}
 				} else {
@@ -122,7 +123,7 @@ func (c *BoltCache) StoreGuilds(guilds []guild.Guild) {
 
 			for _, g := range guilds {
 				if encoded, err := json.Marshal(g.ToCachedGuild()); err == nil {
-					if err := b.Put(toBytes(g.Id), encoded); err != nil {
+					if err := b.Put(toBytes(uint64(g.Id)), encoded); err != nil {
 						return err
 					}
 				} else {
@@ -136,9 +137,9 @@ func (c *BoltCache) StoreGuilds(guilds []guild.Guild) {
 
 	for _, guild := range guilds {
 		c.StoreChannels(guild.Channels)
-		c.StoreMembers(guild.Members, guild.Id)
-		c.StoreRoles(guild.Roles, guild.Id)
-		c.StoreEmojis(guild.Emojis, guild.Id)
+		c.StoreMembers(guild.Members, uint64(guild.Id))
+		c.StoreRoles(guild.Roles, uint64(guild.Id))
+		c.StoreEmojis(guild.Emojis, uint64(guild.Id))
 		c.StoreVoiceStates(guild.VoiceStates)
 	}
 }
@@ -228,7 +229,7 @@ func (c *BoltCache) StoreMembers(members []member.Member, guildId uint64) {
 
 			for _, m := range members {
 				if encoded, err := json.Marshal(m.ToCachedMember()); err == nil {
-					if err := b.Put(memberToBytes(m.User.Id, guildId), encoded); err != nil {
+					if err := b.Put(memberToBytes(uint64(m.User.Id), guildId), encoded); err != nil {
 						return err
 					}
 				} else {
@@ -258,7 +259,7 @@ func (c *BoltCache) GetMember(guildId, userId uint64) (member.Member, bool) {
 
 	u, userFound := c.GetUser(userId)
 	if !userFound {
-		u = user.User{Id:userId}
+		u = user.User{Id:utils.Snowflake(userId)}
 	}
 
 	return cached.ToMember(u), found
@@ -288,13 +289,13 @@ func (c *BoltCache) GetGuildMembers(guildId uint64, withUserData bool) []member.
 
 			var cached member.CachedMember
 			if err := json.Unmarshal(encoded, &cached); err == nil && cachedGuildId == guildId {
-				u := user.User{Id: cachedUserId}
+				u := user.User{Id: utils.Snowflake(cachedUserId)}
 
 				if withUserData {
 					var found bool
 					u, found = c.GetUser(cachedUserId)
 					if !found {
-						u = user.User{Id: cachedUserId}
+						u = user.User{Id: utils.Snowflake(cachedUserId)}
 					}
 				}
 
@@ -334,11 +335,11 @@ func (c *BoltCache) StoreChannels(channels []channel.Channel) {
 			for _, ch := range channels {
 				cwg := channelWithGuild{
 					CachedChannel: ch.ToCachedChannel(),
-					guildId:       ch.GuildId,
+					guildId:       uint64(ch.GuildId),
 				}
 
 				if encoded, err := json.Marshal(cwg); err == nil {
-					if err := b.Put(toBytes(ch.Id), encoded); err != nil {
+					if err := b.Put(toBytes(uint64(ch.Id)), encoded); err != nil {
 						return err
 					}
 				} else {
@@ -423,7 +424,7 @@ func (c *BoltCache) StoreRoles(roles []guild.Role, guildId uint64) {
 				}
 
 				if encoded, err := json.Marshal(rwg); err == nil {
-					if err := b.Put(toBytes(role.Id), encoded); err != nil {
+					if err := b.Put(toBytes(uint64(role.Id)), encoded); err != nil {
 						return err
 					}
 				} else {
@@ -507,7 +508,7 @@ func (c *BoltCache) StoreEmojis(emojis []emoji.Emoji, guildId uint64) {
 			}
 
 			if encoded, err := json.Marshal(ewg); err == nil {
-				if err := b.Put(toBytes(emoji.Id), encoded); err != nil {
+				if err := b.Put(toBytes(uint64(emoji.Id)), encoded); err != nil {
 					return err
 				}
 			} else {
@@ -536,7 +537,7 @@ func (c *BoltCache) GetEmoji(emojiId uint64) (emoji.Emoji, bool) {
 
 	u, userFound := c.GetUser(cached.User)
 	if !userFound {
-		u = user.User{Id: cached.User}
+		u = user.User{Id: utils.Snowflake(cached.User)}
 	}
 
 	emoji := cached.ToEmoji(emojiId, u)
@@ -558,7 +559,7 @@ func (c *BoltCache) GetGuildEmojis(guildId uint64) []emoji.Emoji {
 			if err := json.Unmarshal(encoded, &cached); err == nil && cached.guildId == guildId {
 				u, found := c.GetUser(cached.User)
 				if !found {
-					u = user.User{Id: cached.User}
+					u = user.User{Id: utils.Snowflake(cached.User)}
 				}
 
 				emojis = append(emojis, cached.ToEmoji(emojiId, u))
@@ -590,7 +591,7 @@ func (c *BoltCache) StoreVoiceStates(states []guild.VoiceState) {
 
 		for _, state := range states {
 			if encoded, err := json.Marshal(state.ToCachedVoiceState()); err == nil {
-				if err := b.Put(memberToBytes(state.UserId, state.GuildId), encoded); err != nil {
+				if err := b.Put(memberToBytes(uint64(state.UserId), uint64(state.GuildId)), encoded); err != nil {
 					return err
 				}
 			} else {
@@ -621,7 +622,7 @@ func (c *BoltCache) GetVoiceState(userId, guildId uint64) (guild.VoiceState, boo
 	if !memberFound {
 		u, userFound := c.GetUser(userId)
 		if !userFound {
-			u = user.User{Id: userId}
+			u = user.User{Id: utils.Snowflake(userId)}
 		}
 
 		m = member.Member{User: u}
@@ -658,7 +659,7 @@ func (c *BoltCache) GetGuildVoiceStates(guildId uint64) []guild.VoiceState {
 				if !memberFound {
 					u, userFound := c.GetUser(stateUserId)
 					if !userFound {
-						u = user.User{Id: stateUserId}
+						u = user.User{Id: utils.Snowflake(stateUserId)}
 					}
 
 					m = member.Member{User: u}
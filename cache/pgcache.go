@@ -10,6 +10,7 @@ import (
 	"github.com/rxdn/gdl/objects/guild/emoji"
 	"github.com/rxdn/gdl/objects/member"
 	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/utils"
 	"sync"
 )
 
@@ -182,9 +183,9 @@ func (c *PgCache) StoreGuild(g guild.Guild) {
 	}
 
 	c.StoreChannels(g.Channels)
-	c.StoreRoles(g.Roles, g.Id)
-	c.StoreMembers(g.Members, g.Id)
-	c.StoreEmojis(g.Emojis, g.Id)
+	c.StoreRoles(g.Roles, uint64(g.Id))
+	c.StoreMembers(g.Members, uint64(g.Id))
+	c.StoreEmojis(g.Emojis, uint64(g.Id))
 	c.StoreVoiceStates(g.VoiceStates)
 
 	var users []user.User
@@ -287,7 +288,7 @@ func (c *PgCache) GetGuildMembers(guildId uint64, withUserData bool) (members []
 			userData, _ = c.GetUser(userId)
 		} else {
 			userData = user.User{
-				Id: userId,
+				Id: utils.Snowflake(userId),
 			}
 		}
 
@@ -397,11 +398,11 @@ func (c *PgCache) StoreMembers(members []member.Member, guildId uint64) {
 func (c *PgCache) GetMember(guildId, userId uint64) (member.Member, bool) {
 	var cachedMember member.CachedMember
 	if !c.Options.Members {
-		return cachedMember.ToMember(user.User{Id: userId}), false
+		return cachedMember.ToMember(user.User{Id: utils.Snowflake(userId)}), false
 	}
 
 	if err := c.QueryRow(context.Background(), `SELECT "data" FROM members WHERE "guild_id" = $1 AND "user_id" = $2;`, guildId, userId).Scan(&cachedMember); err != nil {
-		return cachedMember.ToMember(user.User{Id: userId}), false
+		return cachedMember.ToMember(user.User{Id: utils.Snowflake(userId)}), false
 	}
 
 	// fill user field
@@ -587,7 +588,7 @@ func (c *PgCache) StoreVoiceStates(states []guild.VoiceState) {
 func (c *PgCache) GetVoiceState(userId, guildId uint64) (guild.VoiceState, bool) {
 	fakeMember := member.Member{
 		User: user.User{
-			Id: userId,
+			Id: utils.Snowflake(userId),
 		},
 	}
 
@@ -0,0 +1,151 @@
+package cdn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const BaseUrl = "https://cdn.discordapp.com"
+
+// https://discord.com/developers/docs/reference#image-formatting
+type Format string
+
+const (
+	Auto Format = "" // gif if the asset is animated, otherwise webp
+	PNG  Format = "png"
+	JPEG Format = "jpg"
+	WebP Format = "webp"
+	GIF  Format = "gif"
+)
+
+const (
+	MinSize = 16
+	MaxSize = 4096
+)
+
+var (
+	ErrInvalidSize   = errors.New("size must be a power of two between 16 and 4096")
+	ErrInvalidFormat = errors.New("format is not supported for this asset")
+	ErrNoAsset       = errors.New("asset has no hash")
+)
+
+var (
+	staticFormats   = []Format{PNG, JPEG, WebP}
+	animatedFormats = []Format{PNG, JPEG, WebP, GIF}
+)
+
+// ValidSize returns whether size can be requested from the CDN. 0 is valid, and requests the default size
+func ValidSize(size int) bool {
+	if size == 0 {
+		return true
+	}
+
+	return size >= MinSize && size <= MaxSize && size&(size-1) == 0
+}
+
+// IsAnimated returns whether an asset hash refers to an animated asset, which are prefixed with a_
+func IsAnimated(hash string) bool {
+	return strings.HasPrefix(hash, "a_")
+}
+
+// UserAvatar returns the URL of a user's avatar. size 0 requests the default size
+func UserAvatar(userId uint64, hash string, format Format, size int) (string, error) {
+	return buildHashed(fmt.Sprintf("avatars/%d", userId), hash, format, size)
+}
+
+// DefaultUserAvatar returns the URL of the avatar shown for users without an avatar, which is only available as a PNG
+func DefaultUserAvatar(discriminator uint16) string {
+	return fmt.Sprintf("%s/embed/avatars/%d.png", BaseUrl, discriminator%5)
+}
+
+func GuildIcon(guildId uint64, hash string, format Format, size int) (string, error) {
+	return buildHashed(fmt.Sprintf("icons/%d", guildId), hash, format, size)
+}
+
+func GuildSplash(guildId uint64, hash string, format Format, size int) (string, error) {
+	return buildStatic(fmt.Sprintf("splashes/%d", guildId), hash, format, size)
+}
+
+func GuildDiscoverySplash(guildId uint64, hash string, format Format, size int) (string, error) {
+	return buildStatic(fmt.Sprintf("discovery-splashes/%d", guildId), hash, format, size)
+}
+
+func GuildBanner(guildId uint64, hash string, format Format, size int) (string, error) {
+	return buildHashed(fmt.Sprintf("banners/%d", guildId), hash, format, size)
+}
+
+// CustomEmoji returns the URL of a custom emoji. unicode emojis are not served by the CDN
+func CustomEmoji(emojiId uint64, animated bool, format Format, size int) (string, error) {
+	if emojiId == 0 {
+		return "", ErrNoAsset
+	}
+
+	return build(fmt.Sprintf("emojis/%d", emojiId), animated, format, size)
+}
+
+func RoleIcon(roleId uint64, hash string, format Format, size int) (string, error) {
+	return buildStatic(fmt.Sprintf("role-icons/%d", roleId), hash, format, size)
+}
+
+func ApplicationIcon(applicationId uint64, hash string, format Format, size int) (string, error) {
+	return buildStatic(fmt.Sprintf("app-icons/%d", applicationId), hash, format, size)
+}
+
+// buildHashed builds a URL for an asset that may be animated
+func buildHashed(path, hash string, format Format, size int) (string, error) {
+	if hash == "" {
+		return "", ErrNoAsset
+	}
+
+	return build(path+"/"+hash, IsAnimated(hash), format, size)
+}
+
+// buildStatic builds a URL for an asset that is never animated
+func buildStatic(path, hash string, format Format, size int) (string, error) {
+	if hash == "" {
+		return "", ErrNoAsset
+	}
+
+	return build(path+"/"+hash, false, format, size)
+}
+
+func build(path string, animated bool, format Format, size int) (string, error) {
+	if !ValidSize(size) {
+		return "", ErrInvalidSize
+	}
+
+	allowed := staticFormats
+	if animated {
+		allowed = animatedFormats
+	}
+
+	if format == Auto {
+		if animated {
+			format = GIF
+		} else {
+			format = WebP
+		}
+	}
+
+	if !contains(allowed, format) {
+		return "", ErrInvalidFormat
+	}
+
+	url := fmt.Sprintf("%s/%s.%s", BaseUrl, path, format)
+	if size != 0 {
+		url = fmt.Sprintf("%s?size=%d", url, size)
+	}
+
+	return url, nil
+}
+
+func contains(formats []Format, format Format) bool {
+	for _, f := range formats {
+		if f == format {
+			return true
+		}
+	}
+
+	return false
+}
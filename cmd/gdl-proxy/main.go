@@ -0,0 +1,66 @@
+// gdl-proxy is a HTTP proxy that sits in front of the Discord API, allowing many services to share one set of
+// ratelimits. Requests are sent to the proxy as they would be sent to Discord, e.g.
+// http://localhost:8080/api/v6/channels/1234/messages, and are forwarded with the bot token the proxy was started with.
+// Clients must authenticate with the client token, as "Authorization: Bot <client token>". Point GDL at the proxy with
+// ShardOptions.RestProxyUrl.
+package main
+
+import (
+	"flag"
+	"github.com/go-redis/redis"
+	"github.com/rxdn/gdl/logging"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"net/http"
+	"os"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8080", "address to listen on. anyone who can reach the proxy with the client token can use the bot")
+	token := flag.String("token", os.Getenv("DISCORD_TOKEN"), "bot token, defaults to $DISCORD_TOKEN")
+	clientToken := flag.String("client-token", os.Getenv("GDL_PROXY_CLIENT_TOKEN"), "secret that clients must send in the Authorization header, defaults to $GDL_PROXY_CLIENT_TOKEN")
+	redisAddr := flag.String("redis", "", "redis address, to share ratelimits between multiple proxies. uses an in-memory store if not set")
+	redisPrefix := flag.String("redis-prefix", "ratelimiter", "prefix for ratelimit keys in redis")
+	globalLimit := flag.Int("global-limit", ratelimit.DefaultGlobalLimit, "max requests per second, 0 to disable")
+	flag.Parse()
+
+	logger := logging.StandardLogrusLogger()
+
+	if *token == "" {
+		logger.Error("No bot token provided")
+		os.Exit(1)
+	}
+
+	if *clientToken == "" {
+		logger.Error("No client token provided")
+		os.Exit(1)
+	}
+
+	var store ratelimit.RateLimitStore
+	if *redisAddr == "" {
+		store = ratelimit.NewMemoryStore()
+	} else {
+		client := redis.NewClient(&redis.Options{Addr: *redisAddr})
+		if err := client.Ping().Err(); err != nil {
+			logger.Error("Error whilst connecting to redis", logging.Err(err))
+			os.Exit(1)
+		}
+
+		store = ratelimit.NewRedisStore(client, *redisPrefix)
+	}
+
+	rateLimiter := ratelimit.NewRateLimiter(store, 1)
+	rateLimiter.GlobalLimit = *globalLimit
+
+	proxy := &proxy{
+		token:       *token,
+		clientToken: *clientToken,
+		rateLimiter: rateLimiter,
+		logger:      logger,
+	}
+
+	logger.Info("Starting proxy", logging.Any("addr", *addr))
+	if err := http.ListenAndServe(*addr, proxy); err != nil {
+		logger.Error("Error whilst serving", logging.Err(err))
+		os.Exit(1)
+	}
+}
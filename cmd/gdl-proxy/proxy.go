@@ -0,0 +1,101 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"github.com/rxdn/gdl/logging"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"github.com/rxdn/gdl/rest/request"
+	"net/http"
+	"strings"
+)
+
+// headers that are set by net/http, or only apply to the connection between the proxy and discord
+var skippedHeaders = map[string]bool{
+	"Connection":        true,
+	"Content-Length":    true,
+	"Transfer-Encoding": true,
+}
+
+// headers sent by the client that should be passed on to discord
+var forwardedHeaders = []string{
+	"X-Audit-Log-Reason",
+}
+
+type proxy struct {
+	token       string
+	clientToken string
+	rateLimiter *ratelimit.Ratelimiter
+	logger      logging.Logger
+}
+
+func (p *proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !p.authenticated(r) {
+		writeError(w, http.StatusUnauthorized, "401: Unauthorized")
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, request.API_PATH+"/") {
+		writeError(w, http.StatusNotFound, "requests must be made to "+request.API_PATH)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, request.API_PATH)
+	if r.URL.RawQuery != "" {
+		path += "?" + r.URL.RawQuery
+	}
+
+	endpoint := request.Endpoint{
+		RequestType:       request.RequestType(r.Method),
+		ContentType:       request.ContentType(r.Header.Get("Content-Type")),
+		Endpoint:          path,
+		RateLimiter:       p.rateLimiter,
+		AdditionalHeaders: make(map[string]string),
+	}
+
+	for _, header := range forwardedHeaders {
+		if value := r.Header.Get(header); value != "" {
+			endpoint.AdditionalHeaders[header] = value
+		}
+	}
+
+	res, err := endpoint.Forward(r.Context(), p.token, r.Body)
+	if err != nil {
+		p.logger.Warn("Error whilst forwarding request", logging.Route(endpoint.Route()), logging.Err(err))
+		writeError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	for key, values := range res.Header {
+		if skippedHeaders[key] {
+			continue
+		}
+
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+
+	w.WriteHeader(res.StatusCode)
+	_, _ = w.Write(res.Content)
+}
+
+// clients send the client token in the same way that they would send a bot token to discord
+func (p *proxy) authenticated(r *http.Request) bool {
+	expected := "Bot " + p.clientToken
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(expected)) == 1
+}
+
+// errors are in the same format as discord's, so clients can decode them the same way
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}{
+		Code:    0,
+		Message: message,
+	})
+}
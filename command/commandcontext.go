@@ -1,6 +1,7 @@
 package command
 
 import (
+	"context"
 	"github.com/rxdn/gdl/gateway"
 	"github.com/rxdn/gdl/gateway/payloads/events"
 )
@@ -8,5 +9,13 @@ import (
 type CommandContext struct {
 	*events.MessageCreate
 	Shard *gateway.Shard
-	Args []string
+	Args  []string
+
+	// carries the span for the command, pass it to REST calls with request.WithContext
+	Context context.Context
+}
+
+// CleanContent returns the message content with mentions replaced by names from the cache
+func (ctx *CommandContext) CleanContent() string {
+	return ctx.Message.CleanContent(ctx.Shard.Cache)
 }
@@ -1,8 +1,10 @@
 package command
 
 import (
+	"context"
 	"github.com/rxdn/gdl/gateway"
 	"github.com/rxdn/gdl/gateway/payloads/events"
+	"github.com/rxdn/gdl/tracing"
 	"strings"
 )
 
@@ -27,7 +29,7 @@ func (h *CommandHandler) RegisterCommand(cmd Command) {
 	h.commands = append(h.commands, cmd)
 }
 
-func (h *CommandHandler) commandListener(s *gateway.Shard, e *events.MessageCreate) {
+func (h *CommandHandler) commandListener(listenerCtx context.Context, s *gateway.Shard, e *events.MessageCreate) {
 	var isCommand bool
 	var usedPrefix string
 
@@ -97,7 +99,15 @@ func (h *CommandHandler) commandListener(s *gateway.Shard, e *events.MessageCrea
 				}
 			}
 
-			go cmd.Handler(ctx)
+			spanCtx, span := h.shardManager.ShardOptions.Tracer.StartSpan(listenerCtx, tracing.SpanCommand,
+				tracing.String(tracing.KeyCommand, cmd.Name),
+			)
+			ctx.Context = spanCtx
+
+			go func(cmd Command, ctx CommandContext) {
+				defer span.End()
+				cmd.Handler(ctx)
+			}(cmd, ctx)
 		}
 	}
 }
@@ -0,0 +1,131 @@
+package content
+
+import (
+	"fmt"
+	"github.com/rxdn/gdl/markdown"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/guild"
+	"github.com/rxdn/gdl/objects/member"
+	"github.com/rxdn/gdl/objects/user"
+	"strings"
+	"time"
+)
+
+// Resolver looks up the objects referenced by tokens. cache.Cache implements Resolver
+type Resolver interface {
+	GetUser(id uint64) (user.User, bool)
+	GetMember(guildId, userId uint64) (member.Member, bool)
+	GetRole(id uint64) (guild.Role, bool)
+	GetChannel(id uint64) (channel.Channel, bool)
+}
+
+// Clean replaces mentions with the names they refer to, custom emojis with :name:, and timestamps with the time in UTC,
+// as they would be displayed. members' nicknames are used if guildId is not 0. @everyone and @here are escaped, so
+// that the clean content can be sent without mentioning anyone
+func Clean(content string, guildId uint64, resolver Resolver) string {
+	var builder strings.Builder
+
+	last := 0
+	for _, token := range Parse(content) {
+		builder.WriteString(markdown.EscapeMentions(content[last:token.Start]))
+		// names may contain @everyone or @here, e.g. the @everyone role or a nickname
+		builder.WriteString(markdown.EscapeMentions(resolve(token, guildId, resolver)))
+		last = token.End
+	}
+
+	builder.WriteString(markdown.EscapeMentions(content[last:]))
+	return builder.String()
+}
+
+func resolve(token Token, guildId uint64, resolver Resolver) string {
+	switch token.Type {
+	case UserMention:
+		if guildId != 0 {
+			if member, ok := resolver.GetMember(guildId, token.Id); ok && member.Nick != "" {
+				return "@" + member.Nick
+			}
+		}
+
+		if user, ok := resolver.GetUser(token.Id); ok {
+			return "@" + user.Username
+		}
+
+		return "@unknown-user"
+	case RoleMention:
+		if role, ok := resolver.GetRole(token.Id); ok {
+			// the @everyone role's name already starts with @
+			return "@" + strings.TrimPrefix(role.Name, "@")
+		}
+
+		return "@deleted-role"
+	case ChannelMention:
+		if channel, ok := resolver.GetChannel(token.Id); ok {
+			return "#" + channel.Name
+		}
+
+		return "#deleted-channel"
+	case CustomEmoji:
+		return fmt.Sprintf(":%s:", token.Name)
+	case Timestamp:
+		return FormatTimestamp(token.Time, token.Style)
+	default:
+		return token.Raw
+	}
+}
+
+// FormatTimestamp formats t in UTC, as Discord would display a timestamp of the given style
+func FormatTimestamp(t time.Time, style markdown.TimestampStyle) string {
+	t = t.UTC()
+
+	switch style {
+	case markdown.ShortTime:
+		return t.Format("15:04")
+	case markdown.LongTime:
+		return t.Format("15:04:05")
+	case markdown.ShortDate:
+		return t.Format("02/01/2006")
+	case markdown.LongDate:
+		return t.Format("2 January 2006")
+	case markdown.LongDateTime:
+		return t.Format("Monday, 2 January 2006 15:04")
+	case markdown.RelativeTime:
+		return relativeTime(t, time.Now())
+	default:
+		return t.Format("2 January 2006 15:04")
+	}
+}
+
+func relativeTime(t, now time.Time) string {
+	diff := t.Sub(now)
+	future := diff > 0
+	if !future {
+		diff = -diff
+	}
+
+	var amount int
+	var unit string
+	switch {
+	case diff < time.Minute:
+		amount, unit = int(diff/time.Second), "second"
+	case diff < time.Hour:
+		amount, unit = int(diff/time.Minute), "minute"
+	case diff < time.Hour*24:
+		amount, unit = int(diff/time.Hour), "hour"
+	case diff < time.Hour*24*30:
+		amount, unit = int(diff/(time.Hour*24)), "day"
+	case diff < time.Hour*24*365:
+		amount, unit = int(diff/(time.Hour*24*30)), "month"
+	default:
+		amount, unit = int(diff/(time.Hour*24*365)), "year"
+	}
+
+	if amount != 1 {
+		unit += "s"
+	}
+
+	if future {
+		return fmt.Sprintf("in %d %s", amount, unit)
+	}
+
+	return fmt.Sprintf("%d %s ago", amount, unit)
+}
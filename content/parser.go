@@ -0,0 +1,132 @@
+package content
+
+import (
+	"github.com/rxdn/gdl/markdown"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+type TokenType int
+
+const (
+	UserMention TokenType = iota
+	RoleMention
+	ChannelMention
+	CustomEmoji
+	Timestamp
+	Url
+)
+
+func (t TokenType) String() string {
+	switch t {
+	case UserMention:
+		return "user mention"
+	case RoleMention:
+		return "role mention"
+	case ChannelMention:
+		return "channel mention"
+	case CustomEmoji:
+		return "custom emoji"
+	case Timestamp:
+		return "timestamp"
+	case Url:
+		return "url"
+	default:
+		return "unknown"
+	}
+}
+
+type Token struct {
+	Type  TokenType
+	Start int // byte offset of the token in the content
+	End   int // byte offset after the token
+	Raw   string
+
+	Id       uint64                  // users, roles, channels and emojis
+	Nickname bool                    // whether a user was mentioned as <@!id>
+	Name     string                  // emoji name
+	Animated bool                    // whether an emoji is animated
+	Time     time.Time               // timestamps
+	Style    markdown.TimestampStyle // timestamps, blank if no style was given
+}
+
+// each alternative has its own groups, which are used to tell which type of token was matched
+var tokenRegex = regexp.MustCompile(
+	`<@(!?)(\d+)>` + // user
+		`|<@&(\d+)>` + // role
+		`|<#(\d+)>` + // channel
+		`|<(a?):(\w{2,32}):(\d+)>` + // emoji
+		`|<t:(-?\d+)(?::([tTdDfFR]))?>` + // timestamp
+		`|(https?://[^\s<>]*[^\s<>.,:;"')\]!?])`, // url, excluding trailing punctuation
+)
+
+// Parse returns the mentions, custom emojis, timestamps and URLs in content, in the order that they appear
+func Parse(content string) []Token {
+	var tokens []Token
+
+	for _, match := range tokenRegex.FindAllStringSubmatchIndex(content, -1) {
+		group := func(i int) string {
+			if match[i*2] < 0 {
+				return ""
+			}
+
+			return content[match[i*2]:match[i*2+1]]
+		}
+
+		token := Token{
+			Start: match[0],
+			End:   match[1],
+			Raw:   content[match[0]:match[1]],
+		}
+
+		var err error
+		switch {
+		case match[4] >= 0:
+			token.Type = UserMention
+			token.Nickname = group(1) == "!"
+			token.Id, err = strconv.ParseUint(group(2), 10, 64)
+		case match[6] >= 0:
+			token.Type = RoleMention
+			token.Id, err = strconv.ParseUint(group(3), 10, 64)
+		case match[8] >= 0:
+			token.Type = ChannelMention
+			token.Id, err = strconv.ParseUint(group(4), 10, 64)
+		case match[14] >= 0:
+			token.Type = CustomEmoji
+			token.Animated = group(5) == "a"
+			token.Name = group(6)
+			token.Id, err = strconv.ParseUint(group(7), 10, 64)
+		case match[16] >= 0:
+			token.Type = Timestamp
+			token.Style = markdown.TimestampStyle(group(9))
+
+			var seconds int64
+			seconds, err = strconv.ParseInt(group(8), 10, 64)
+			token.Time = time.Unix(seconds, 0)
+		default:
+			token.Type = Url
+		}
+
+		// ids that overflow a uint64 are not valid mentions
+		if err != nil {
+			continue
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+// Filter returns the tokens of the given type
+func Filter(tokens []Token, tokenType TokenType) []Token {
+	var filtered []Token
+	for _, token := range tokens {
+		if token.Type == tokenType {
+			filtered = append(filtered, token)
+		}
+	}
+
+	return filtered
+}
@@ -54,7 +54,7 @@ func registerCommands(sm *gateway.ShardManager) {
 
 func onCommand(ctx command.CommandContext) {
 	if len(ctx.Mentions) == 0 {
-		_, _ = ctx.Shard.CreateMessage(ctx.ChannelId, "You need to mention a user")
+		_, _ = ctx.Shard.CreateMessage(uint64(ctx.ChannelId), "You need to mention a user")
 		return
 	}
 
@@ -67,13 +67,15 @@ func onCommand(ctx command.CommandContext) {
 
 		data := rest.CreateMessageData{
 			Content:         fmt.Sprintf("%s's avatar is:", mention.Username),
-			File:            &rest.File{
-				Name:        "avatar.png",
-				ContentType: res.Header.Get("Content-Type"),
-				Reader:      res.Body,
+			Files: []*rest.File{
+				{
+					Name:        "avatar.png",
+					ContentType: res.Header.Get("Content-Type"),
+					Reader:      res.Body,
+				},
 			},
 		}
 
-		ctx.Shard.CreateMessageComplex(ctx.ChannelId, data)
+		ctx.Shard.CreateMessageComplex(uint64(ctx.ChannelId), data)
 	}
 }
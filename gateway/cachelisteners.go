@@ -3,7 +3,6 @@ package gateway
 import (
 	"github.com/rxdn/gdl/gateway/payloads/events"
 	"github.com/rxdn/gdl/objects/member"
-	"github.com/sirupsen/logrus"
 )
 
 func RegisterCacheListeners(sm *ShardManager) {
@@ -29,9 +28,11 @@ func RegisterCacheListeners(sm *ShardManager) {
 }
 
 func readyListener(s *Shard, e *events.Ready) {
-	logrus.Infof("shard %d: received ready", s.ShardId)
+	s.Logger.Info("Received ready")
 
+	s.sessionLock.Lock()
 	s.sessionId = e.SessionId
+	s.sessionLock.Unlock()
 
 	s.Cache.StoreSelf(e.User)
 
@@ -48,7 +49,7 @@ func channelUpdateListener(s *Shard, e *events.ChannelUpdate) {
 }
 
 func channelDeleteListener(s *Shard, e *events.ChannelDelete) {
-	s.Cache.DeleteChannel(e.Channel.Id)
+	s.Cache.DeleteChannel(uint64(e.Channel.Id))
 }
 
 func guildCreateListener(s *Shard, e *events.GuildCreate) {
@@ -60,7 +61,7 @@ func guildUpdateListener(s *Shard, e *events.GuildUpdate) {
 }
 
 func guildDeleteListener(s *Shard, e *events.GuildDelete) {
-	s.Cache.DeleteGuild(e.Id)
+	s.Cache.DeleteGuild(uint64(e.Id))
 }
 
 func guildEmojisUpdateListeners(s *Shard, e *events.GuildEmojisUpdate) {
@@ -74,7 +75,7 @@ func guildMemberAddListener(s *Shard, e *events.GuildMemberAdd) {
 }
 
 func guildMemberRemoveListener(s *Shard, e *events.GuildMemberRemove) {
-	s.Cache.DeleteMember(e.User.Id, e.GuildId)
+	s.Cache.DeleteMember(uint64(e.User.Id), e.GuildId)
 }
 
 func guildMemberUpdateListener(s *Shard, e *events.GuildMemberUpdate) {
@@ -1,39 +1,70 @@
 package gateway
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/rxdn/gdl/gateway/payloads/events"
-	"github.com/sirupsen/logrus"
+	"github.com/rxdn/gdl/logging"
+	"github.com/rxdn/gdl/tracing"
 	"reflect"
+	"runtime"
 )
 
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// Listeners may either be func(*Shard, *events.T), or func(context.Context, *Shard, *events.T), in which case the
+// context carries the span for the listener invocation
 func (s *Shard) ExecuteEvent(eventType events.EventType, data json.RawMessage) {
 	dataType := events.EventTypes[eventType]
 	if dataType == nil {
 		return
 	}
 
+	tracer := s.ShardManager.ShardOptions.Tracer
+	ctx, span := tracer.StartSpan(context.Background(), tracing.SpanEvent,
+		tracing.String(tracing.KeyEvent, string(eventType)),
+		tracing.Int(tracing.KeyShardId, s.ShardId),
+	)
+	defer span.End()
+
 	event := reflect.New(dataType)
 	if err := json.Unmarshal(data, event.Interface()); err != nil {
-		logrus.Warnf("error whilst decoding event data: %s", err.Error())
+		s.Logger.Warn("Error whilst decoding event data", logging.Event(string(eventType)), logging.Err(err))
+		span.RecordError(err)
 	}
-	
+
 	for _, listener := range s.ShardManager.EventBus.Listeners {
 		fn := reflect.TypeOf(listener)
-		if fn.NumIn() != 2 {
+
+		var withContext bool
+		switch fn.NumIn() {
+		case 2:
+		case 3:
+			if fn.In(0) != contextType {
+				continue
+			}
+			withContext = true
+		default:
 			continue
 		}
 
-		ptr := fn.In(1)
+		ptr := fn.In(fn.NumIn() - 1)
 		if ptr.Kind() != reflect.Ptr {
 			continue
 		}
 
 		if ptr.Elem() == dataType {
-			reflect.ValueOf(listener).Call([]reflect.Value{
-				reflect.ValueOf(s),
-				event,
-			})
+			listenerCtx, listenerSpan := tracer.StartSpan(ctx, tracing.SpanListener,
+				tracing.String(tracing.KeyListener, runtime.FuncForPC(reflect.ValueOf(listener).Pointer()).Name()),
+			)
+
+			args := []reflect.Value{reflect.ValueOf(s), event}
+			if withContext {
+				args = append([]reflect.Value{reflect.ValueOf(listenerCtx)}, args...)
+			}
+
+			reflect.ValueOf(listener).Call(args)
+			listenerSpan.End()
 		}
 	}
-}
\ No newline at end of file
+}
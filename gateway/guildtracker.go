@@ -0,0 +1,240 @@
+package gateway
+
+import (
+	"encoding/json"
+	"github.com/rxdn/gdl/gateway/payloads/events"
+	"github.com/rxdn/gdl/logging"
+	"sync"
+	"time"
+)
+
+// guildTracker keeps track of which guilds a shard has received. events are executed concurrently, so this is updated
+// synchronously whilst reading, rather than in a listener.
+type guildTracker struct {
+	sync.RWMutex
+	readyReceived bool
+	readyFired    bool                // whether SHARD_READY has been dispatched for this session
+	readyTimer    *time.Timer         // fires SHARD_READY if we stop receiving guilds
+	session       int                 // incremented on reset, so that timers from previous sessions are ignored
+	guilds        map[uint64]struct{} // guilds received on this session
+	pending       map[uint64]struct{} // guilds sent in READY that we have not received a GUILD_CREATE for yet
+	unavailable   map[uint64]struct{} // guilds that have become unavailable due to an outage
+}
+
+type trackedGuild struct {
+	Id          uint64 `json:"id,string"`
+	Unavailable *bool  `json:"unavailable"`
+}
+
+type syntheticEvent struct {
+	eventType events.EventType
+	data      json.RawMessage
+}
+
+func newGuildTracker() *guildTracker {
+	return &guildTracker{
+		guilds:      make(map[uint64]struct{}),
+		pending:     make(map[uint64]struct{}),
+		unavailable: make(map[uint64]struct{}),
+	}
+}
+
+// called when identifying, as we will receive a new READY
+func (t *guildTracker) reset() {
+	t.Lock()
+	t.readyReceived = false
+	t.readyFired = false
+	t.session++
+	if t.readyTimer != nil {
+		t.readyTimer.Stop()
+		t.readyTimer = nil
+	}
+	t.guilds = make(map[uint64]struct{})
+	t.pending = make(map[uint64]struct{})
+	t.unavailable = make(map[uint64]struct{})
+	t.Unlock()
+}
+
+// trackGuilds updates the guild tracker, and returns the synthetic events that should be dispatched after the event
+func (s *Shard) trackGuilds(eventType events.EventType, data json.RawMessage) []syntheticEvent {
+	switch eventType {
+	case events.READY:
+		var ready struct {
+			Guilds []trackedGuild `json:"guilds"`
+		}
+
+		if err := json.Unmarshal(data, &ready); err != nil {
+			s.Logger.Warn("Error whilst decoding guilds", logging.Event(string(eventType)), logging.Err(err))
+			return nil
+		}
+
+		s.guildTracker.Lock()
+		defer s.guildTracker.Unlock()
+
+		s.guildTracker.readyReceived = true
+		for _, guild := range ready.Guilds {
+			if _, received := s.guildTracker.guilds[guild.Id]; !received {
+				s.guildTracker.pending[guild.Id] = struct{}{}
+			}
+		}
+
+		if len(s.guildTracker.pending) == 0 {
+			return s.shardReady(false)
+		}
+
+		s.resetReadyTimer()
+	case events.GUILD_CREATE:
+		var guild trackedGuild
+		if err := json.Unmarshal(data, &guild); err != nil {
+			s.Logger.Warn("Error whilst decoding guild", logging.Event(string(eventType)), logging.Err(err))
+			return nil
+		}
+
+		s.guildTracker.Lock()
+		defer s.guildTracker.Unlock()
+
+		_, isPending := s.guildTracker.pending[guild.Id]
+		_, wasUnavailable := s.guildTracker.unavailable[guild.Id]
+		_, alreadyReceived := s.guildTracker.guilds[guild.Id]
+
+		delete(s.guildTracker.pending, guild.Id)
+		delete(s.guildTracker.unavailable, guild.Id)
+		s.guildTracker.guilds[guild.Id] = struct{}{}
+
+		var synthetic []syntheticEvent
+		if isPending || wasUnavailable || alreadyReceived {
+			synthetic = append(synthetic, syntheticEvent{eventType: events.GUILD_AVAILABLE, data: data})
+		} else {
+			synthetic = append(synthetic, syntheticEvent{eventType: events.GUILD_JOIN, data: data})
+		}
+
+		if isPending && !s.guildTracker.readyFired {
+			if len(s.guildTracker.pending) == 0 {
+				synthetic = append(synthetic, s.shardReady(false)...)
+			} else {
+				s.resetReadyTimer()
+			}
+		}
+
+		return synthetic
+	case events.GUILD_DELETE:
+		var guild trackedGuild
+		if err := json.Unmarshal(data, &guild); err != nil {
+			s.Logger.Warn("Error whilst decoding guild", logging.Event(string(eventType)), logging.Err(err))
+			return nil
+		}
+
+		s.guildTracker.Lock()
+		defer s.guildTracker.Unlock()
+
+		delete(s.guildTracker.guilds, guild.Id)
+
+		// if unavailable is not set, the bot was removed from the guild
+		if guild.Unavailable != nil && *guild.Unavailable {
+			s.guildTracker.unavailable[guild.Id] = struct{}{}
+			return []syntheticEvent{{eventType: events.GUILD_UNAVAILABLE, data: data}}
+		}
+	}
+
+	return nil
+}
+
+// guildTracker lock must be held by the caller
+func (s *Shard) resetReadyTimer() {
+	if s.guildTracker.readyTimer != nil {
+		s.guildTracker.readyTimer.Stop()
+	}
+
+	session := s.guildTracker.session
+	s.guildTracker.readyTimer = time.AfterFunc(s.ShardManager.ShardOptions.GuildReadyTimeout, func() {
+		s.guildTracker.Lock()
+		if s.guildTracker.session != session || s.guildTracker.readyFired {
+			s.guildTracker.Unlock()
+			return
+		}
+
+		pending := len(s.guildTracker.pending)
+		synthetic := s.shardReady(true)
+		s.guildTracker.Unlock()
+
+		s.Logger.Info("Timed out waiting for guilds", logging.Any("pending", pending))
+		for _, event := range synthetic {
+			s.ExecuteEvent(event.eventType, event.data)
+		}
+	})
+}
+
+// guildTracker lock must be held by the caller
+func (s *Shard) shardReady(timedOut bool) []syntheticEvent {
+	s.guildTracker.readyFired = true
+	if s.guildTracker.readyTimer != nil {
+		s.guildTracker.readyTimer.Stop()
+		s.guildTracker.readyTimer = nil
+	}
+
+	unavailable := make([]uint64, 0, len(s.guildTracker.pending))
+	for guildId := range s.guildTracker.pending {
+		unavailable = append(unavailable, guildId)
+	}
+
+	event := events.ShardReady{
+		ShardId:           s.ShardId,
+		GuildCount:        len(s.guildTracker.guilds),
+		TimedOut:          timedOut,
+		UnavailableGuilds: unavailable,
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		s.Logger.Warn("Error whilst encoding SHARD_READY", logging.Err(err))
+		return nil
+	}
+
+	return []syntheticEvent{{eventType: events.SHARD_READY, data: data}}
+}
+
+func (s *Shard) GuildCount() int {
+	s.guildTracker.RLock()
+	defer s.guildTracker.RUnlock()
+	return len(s.guildTracker.guilds)
+}
+
+// PendingGuildCount returns the number of guilds sent in READY that have not yet been received
+func (s *Shard) PendingGuildCount() int {
+	s.guildTracker.RLock()
+	defer s.guildTracker.RUnlock()
+	return len(s.guildTracker.pending)
+}
+
+// UnavailableGuilds returns the IDs of guilds that were sent in READY but have not been received yet, or that are
+// currently unavailable due to an outage
+func (s *Shard) UnavailableGuilds() []uint64 {
+	s.guildTracker.RLock()
+	defer s.guildTracker.RUnlock()
+
+	guilds := make([]uint64, 0, len(s.guildTracker.pending)+len(s.guildTracker.unavailable))
+	for guildId := range s.guildTracker.pending {
+		guilds = append(guilds, guildId)
+	}
+
+	for guildId := range s.guildTracker.unavailable {
+		guilds = append(guilds, guildId)
+	}
+
+	return guilds
+}
+
+// IsReady returns whether ShardReady has been dispatched for this session, either because every guild in READY was
+// received, or because GuildReadyTimeout passed. guilds that are still unavailable are counted by PendingGuildCount
+func (s *Shard) IsReady() bool {
+	s.guildTracker.RLock()
+	defer s.guildTracker.RUnlock()
+	return s.guildTracker.readyFired
+}
+
+// AllGuildsReceived returns whether READY has been received, and a GUILD_CREATE has been received for every guild in it
+func (s *Shard) AllGuildsReceived() bool {
+	s.guildTracker.RLock()
+	defer s.guildTracker.RUnlock()
+	return s.guildTracker.readyReceived && len(s.guildTracker.pending) == 0
+}
@@ -0,0 +1,43 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type statusHandler struct {
+	sm            *ShardManager
+	requiresReady bool
+}
+
+// LivenessHandler serves the ShardManager's Status as JSON, always responding with 200 OK
+func (sm *ShardManager) LivenessHandler() http.Handler {
+	return statusHandler{
+		sm:            sm,
+		requiresReady: false,
+	}
+}
+
+// ReadinessHandler serves the ShardManager's Status as JSON, responding with 503 Service Unavailable unless all shards
+// are CONNECTED and have dispatched ShardReady. guilds that are still unavailable after GuildReadyTimeout don't prevent
+// readiness, but are counted in PendingGuilds
+func (sm *ShardManager) ReadinessHandler() http.Handler {
+	return statusHandler{
+		sm:            sm,
+		requiresReady: true,
+	}
+}
+
+func (h statusHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	status := h.sm.Status()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if h.requiresReady && !status.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	_ = json.NewEncoder(w).Encode(status)
+}
@@ -2,8 +2,8 @@ package gateway
 
 import (
 	"github.com/rxdn/gdl/gateway/payloads"
+	"github.com/rxdn/gdl/logging"
 	"github.com/rxdn/gdl/utils"
-	"github.com/sirupsen/logrus"
 	"time"
 )
 
@@ -20,7 +20,7 @@ func (s *Shard) CountdownHeartbeat(ticker *time.Ticker) {
 			// Check we received an ACK
 			timeElapsed := s.lastHeartbeatAcknowledgement - s.lastHeartbeat
 			if s.hasDoneHeartbeat && timeElapsed > int64(s.heartbeatInterval) {
-				logrus.Warnf("shard %d didn't receive acknowledgement, restarting", s.ShardId)
+				s.Logger.Warn("Didn't receive heartbeat acknowledgement, restarting")
 				s.heartbeatLock.RUnlock()
 				s.Kill()
 				go s.EnsureConnect()
@@ -30,7 +30,7 @@ func (s *Shard) CountdownHeartbeat(ticker *time.Ticker) {
 			s.heartbeatLock.RUnlock()
 
 			if err := s.Heartbeat(); err != nil {
-				logrus.Warnf("shard %d heartbeat failed, restarting: %s", s.ShardId, err.Error())
+				s.Logger.Warn("Heartbeat failed, restarting", logging.Err(err))
 				s.Kill()
 				go s.EnsureConnect()
 			}
@@ -1,8 +1,13 @@
 package gateway
 
+import (
+	"github.com/rxdn/gdl/rest/request"
+	"github.com/rxdn/gdl/tracing"
+)
+
 func (s *Shard) SelfId() uint64 {
 	self, _ := s.Cache.GetSelf()
-	return self.Id
+	return uint64(self.Id)
 }
 
 func (s *Shard) SelfAvatar(size int) string {
@@ -11,7 +16,7 @@ func (s *Shard) SelfAvatar(size int) string {
 }
 
 func (s *Shard) SelfUsername() string {
-	self ,_ := s.Cache.GetSelf()
+	self, _ := s.Cache.GetSelf()
 	return self.Username
 }
 
@@ -19,3 +24,27 @@ func (s *Shard) SelfUsername() string {
 func (s *Shard) HeartbeatLatency() int64 {
 	return s.lastHeartbeatAcknowledgement - s.lastHeartbeat
 }
+
+type cacheLookup struct {
+	shard    *Shard
+	resource string
+	span     tracing.Span
+}
+
+func (s *Shard) startCacheLookup(resource string, opts []request.Option) cacheLookup {
+	ctx := request.ApplyOptions(opts...).Context
+	_, span := s.ShardManager.ShardOptions.Tracer.StartSpan(ctx, tracing.SpanCacheLookup, tracing.String(tracing.KeyCacheResource, resource))
+
+	return cacheLookup{
+		shard:    s,
+		resource: resource,
+		span:     span,
+	}
+}
+
+func (l cacheLookup) end(hit bool) {
+	l.shard.ShardManager.ShardOptions.Metrics.CacheLookup(l.resource, hit)
+
+	l.span.SetAttributes(tracing.Bool(tracing.KeyCacheHit, hit))
+	l.span.End()
+}
@@ -41,4 +41,10 @@ const (
 	VOICE_STATE_UPDATE            EventType = "VOICE_STATE_UPDATE"
 	VOICE_SERVER_UPDATE           EventType = "VOICE_SERVER_UPDATE"
 	WEBHOOKS_UPDATE               EventType = "WEBHOOKS_UPDATE"
+
+	// Synthetic events, dispatched by GDL rather than Discord
+	SHARD_READY       EventType = "SHARD_READY"
+	GUILD_AVAILABLE   EventType = "GUILD_AVAILABLE"
+	GUILD_JOIN        EventType = "GUILD_JOIN"
+	GUILD_UNAVAILABLE EventType = "GUILD_UNAVAILABLE"
 )
@@ -41,4 +41,8 @@ var EventTypes = map[EventType]reflect.Type{
 	VOICE_STATE_UPDATE:            reflect.TypeOf(VoiceStateUpdate{}),
 	VOICE_SERVER_UPDATE:           reflect.TypeOf(VoiceStateUpdate{}),
 	WEBHOOKS_UPDATE:               reflect.TypeOf(WebhooksUpdate{}),
+	SHARD_READY:                   reflect.TypeOf(ShardReady{}),
+	GUILD_AVAILABLE:               reflect.TypeOf(GuildAvailable{}),
+	GUILD_JOIN:                    reflect.TypeOf(GuildJoin{}),
+	GUILD_UNAVAILABLE:             reflect.TypeOf(GuildUnavailable{}),
 }
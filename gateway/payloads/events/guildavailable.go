@@ -0,0 +1,11 @@
+package events
+
+import (
+	"github.com/rxdn/gdl/objects/guild"
+)
+
+// Synthetic event, dispatched alongside GuildCreate when a guild is loaded after connecting, or comes back from an
+// outage
+type GuildAvailable struct {
+	guild.Guild
+}
@@ -0,0 +1,10 @@
+package events
+
+import (
+	"github.com/rxdn/gdl/objects/guild"
+)
+
+// Synthetic event, dispatched alongside GuildCreate when the bot is added to a new guild
+type GuildJoin struct {
+	guild.Guild
+}
@@ -0,0 +1,6 @@
+package events
+
+// Synthetic event, dispatched alongside GuildDelete when a guild becomes unavailable due to an outage
+type GuildUnavailable struct {
+	Id uint64 `json:"id,string"`
+}
@@ -0,0 +1,12 @@
+package events
+
+import "github.com/rxdn/gdl/utils"
+
+// Synthetic event, dispatched once a shard has received all of the guilds sent in READY, or no guilds have been
+// received for ShardOptions.GuildReadyTimeout
+type ShardReady struct {
+	ShardId           int                     `json:"shard_id"`
+	GuildCount        int                     `json:"guild_count"`
+	TimedOut          bool                    `json:"timed_out"`
+	UnavailableGuilds utils.Uint64StringSlice `json:"unavailable_guilds"` // guilds from READY that were not received
+}
@@ -1,6 +1,7 @@
 package gateway
 
 import (
+	"github.com/rxdn/gdl/objects/auditlog"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/channel/embed"
 	"github.com/rxdn/gdl/objects/channel/message"
@@ -11,18 +12,22 @@ import (
 	"github.com/rxdn/gdl/objects/member"
 	"github.com/rxdn/gdl/objects/user"
 	"github.com/rxdn/gdl/rest"
+	"github.com/rxdn/gdl/rest/request"
 	"image"
 )
 
-func (s *Shard) GetChannel(channelId uint64) (channel.Channel, error) {
+func (s *Shard) GetChannel(channelId uint64, opts ...request.Option) (channel.Channel, error) {
 	shouldCache := s.Cache.GetOptions().Channels
 	if shouldCache {
-		if cached, found := s.Cache.GetChannel(channelId); found {
+		lookup := s.startCacheLookup("channel", opts)
+		cached, found := s.Cache.GetChannel(channelId)
+		lookup.end(found)
+		if found {
 			return cached, nil
 		}
 	}
 
-	channel, err := rest.GetChannel(s.Token, s.ShardManager.RateLimiter, channelId)
+	channel, err := s.ShardManager.RestClient.GetChannel(channelId, opts...)
 
 	if shouldCache && err == nil {
 		go s.Cache.StoreChannel(channel)
@@ -31,8 +36,8 @@ func (s *Shard) GetChannel(channelId uint64) (channel.Channel, error) {
 	return channel, err
 }
 
-func (s *Shard) ModifyChannel(channelId uint64, data rest.ModifyChannelData) (channel.Channel, error) {
-	channel, err := rest.ModifyChannel(s.Token, s.ShardManager.RateLimiter, channelId, data)
+func (s *Shard) ModifyChannel(channelId uint64, data rest.ModifyChannelData, opts ...request.Option) (channel.Channel, error) {
+	channel, err := s.ShardManager.RestClient.ModifyChannel(channelId, data, opts...)
 
 	if s.Cache.GetOptions().Channels && err != nil {
 		go s.Cache.StoreChannel(channel)
@@ -41,113 +46,139 @@ func (s *Shard) ModifyChannel(channelId uint64, data rest.ModifyChannelData) (ch
 	return channel, err
 }
 
-func (s *Shard) DeleteChannel(channelId uint64) (channel.Channel, error) {
-	return rest.DeleteChannel(s.Token, s.ShardManager.RateLimiter, channelId)
+func (s *Shard) DeleteChannel(channelId uint64, opts ...request.Option) (channel.Channel, error) {
+	return s.ShardManager.RestClient.DeleteChannel(channelId, opts...)
 }
 
-func (s *Shard) GetChannelMessages(channelId uint64, options rest.GetChannelMessagesData) ([]message.Message, error) {
-	return rest.GetChannelMessages(s.Token, s.ShardManager.RateLimiter, channelId, options)
+func (s *Shard) GetChannelMessages(channelId uint64, options rest.GetChannelMessagesData, opts ...request.Option) ([]message.Message, error) {
+	return s.ShardManager.RestClient.GetChannelMessages(channelId, options, opts...)
 }
 
-func (s *Shard) GetChannelMessage(channelId, messageId uint64) (message.Message, error) {
-	return rest.GetChannelMessage(s.Token, s.ShardManager.RateLimiter, channelId, messageId)
+func (s *Shard) MessageIterator(channelId uint64, options rest.IteratorOptions, opts ...request.Option) *rest.MessageIterator {
+	return s.ShardManager.RestClient.MessageIterator(channelId, options, opts...)
 }
 
-func (s *Shard) CreateMessage(channelId uint64, content string) (message.Message, error) {
+func (s *Shard) GetChannelMessage(channelId, messageId uint64, opts ...request.Option) (message.Message, error) {
+	return s.ShardManager.RestClient.GetChannelMessage(channelId, messageId, opts...)
+}
+
+func (s *Shard) CreateMessage(channelId uint64, content string, opts ...request.Option) (message.Message, error) {
 	return s.CreateMessageComplex(channelId, rest.CreateMessageData{
 		Content: content,
-	})
+	}, opts...)
 }
 
-func (s *Shard) CreateMessageEmbed(channelId uint64, embed *embed.Embed) (message.Message, error) {
+func (s *Shard) CreateMessageEmbed(channelId uint64, embed *embed.Embed, opts ...request.Option) (message.Message, error) {
 	return s.CreateMessageComplex(channelId, rest.CreateMessageData{
 		Embed: embed,
-	})
+	}, opts...)
+}
+
+func (s *Shard) CreateMessageComplex(channelId uint64, data rest.CreateMessageData, opts ...request.Option) (message.Message, error) {
+	return s.ShardManager.RestClient.CreateMessage(channelId, data, opts...)
+}
+
+// CreateMessageChunked sends content that is too long for a single message as multiple messages
+func (s *Shard) CreateMessageChunked(channelId uint64, content string, opts ...request.Option) ([]message.Message, error) {
+	return s.CreateMessageChunkedComplex(channelId, rest.CreateMessageData{
+		Content: content,
+	}, opts...)
+}
+
+func (s *Shard) CreateMessageChunkedComplex(channelId uint64, data rest.CreateMessageData, opts ...request.Option) ([]message.Message, error) {
+	return s.ShardManager.RestClient.CreateMessageChunked(channelId, data, opts...)
 }
 
-func (s *Shard) CreateMessageComplex(channelId uint64, data rest.CreateMessageData) (message.Message, error) {
-	return rest.CreateMessage(s.Token, s.ShardManager.RateLimiter, channelId, data)
+func (s *Shard) CreateReaction(channelId, messageId uint64, emoji string, opts ...request.Option) error {
+	return s.ShardManager.RestClient.CreateReaction(channelId, messageId, emoji, opts...)
 }
 
-func (s *Shard) CreateReaction(channelId, messageId uint64, emoji string) error {
-	return rest.CreateReaction(s.Token, s.ShardManager.RateLimiter, channelId, messageId, emoji)
+func (s *Shard) DeleteOwnReaction(channelId, messageId uint64, emoji string, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteOwnReaction(channelId, messageId, emoji, opts...)
 }
 
-func (s *Shard) DeleteOwnReaction(channelId, messageId uint64, emoji string) error {
-	return rest.DeleteOwnReaction(s.Token, s.ShardManager.RateLimiter, channelId, messageId, emoji)
+func (s *Shard) DeleteUserReaction(channelId, messageId, userId uint64, emoji string, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteUserReaction(channelId, messageId, userId, emoji, opts...)
 }
 
-func (s *Shard) DeleteUserReaction(channelId, messageId, userId uint64, emoji string) error {
-	return rest.DeleteUserReaction(s.Token, s.ShardManager.RateLimiter, channelId, messageId, userId, emoji)
+func (s *Shard) GetReactions(channelId, messageId uint64, emoji string, options rest.GetReactionsData, opts ...request.Option) ([]user.User, error) {
+	return s.ShardManager.RestClient.GetReactions(channelId, messageId, emoji, options, opts...)
 }
 
-func (s *Shard) GetReactions(channelId, messageId uint64, emoji string, options rest.GetReactionsData) ([]user.User, error) {
-	return rest.GetReactions(s.Token, s.ShardManager.RateLimiter, channelId, messageId, emoji, options)
+func (s *Shard) ReactionIterator(channelId, messageId uint64, emoji string, options rest.IteratorOptions, opts ...request.Option) *rest.ReactionIterator {
+	return s.ShardManager.RestClient.ReactionIterator(channelId, messageId, emoji, options, opts...)
 }
 
-func (s *Shard) DeleteAllReactions(channelId, messageId uint64) error {
-	return rest.DeleteAllReactions(s.Token, s.ShardManager.RateLimiter, channelId, messageId)
+func (s *Shard) DeleteAllReactions(channelId, messageId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteAllReactions(channelId, messageId, opts...)
 }
 
-func (s *Shard) DeleteAllReactionsEmoji(channelId, messageId uint64, emoji string) error {
-	return rest.DeleteAllReactionsEmoji(s.Token, s.ShardManager.RateLimiter, channelId, messageId, emoji)
+func (s *Shard) DeleteAllReactionsEmoji(channelId, messageId uint64, emoji string, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteAllReactionsEmoji(channelId, messageId, emoji, opts...)
 }
 
-func (s *Shard) EditMessage(channelId, messageId uint64, data rest.ModifyChannelData) (message.Message, error) {
-	return rest.EditMessage(s.Token, s.ShardManager.RateLimiter, channelId, messageId, data)
+func (s *Shard) EditMessage(channelId, messageId uint64, data rest.EditMessageData, opts ...request.Option) (message.Message, error) {
+	return s.ShardManager.RestClient.EditMessage(channelId, messageId, data, opts...)
 }
 
-func (s *Shard) DeleteMessage(channelId, messageId uint64) error {
-	return rest.DeleteMessage(s.Token, s.ShardManager.RateLimiter, channelId, messageId)
+func (s *Shard) DeleteMessage(channelId, messageId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteMessage(channelId, messageId, opts...)
 }
 
-func (s *Shard) BulkDeleteMessages(channelId uint64, messages []uint64) error {
-	return rest.BulkDeleteMessages(s.Token, s.ShardManager.RateLimiter, channelId, messages)
+func (s *Shard) BulkDeleteMessages(channelId uint64, messages []uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.BulkDeleteMessages(channelId, messages, opts...)
 }
 
-func (s *Shard) EditChannelPermissions(channelId uint64, updated channel.PermissionOverwrite) error {
-	return rest.EditChannelPermissions(s.Token, s.ShardManager.RateLimiter, channelId, updated)
+func (s *Shard) PurgeMessages(channelId uint64, filter rest.PurgeFilter, limit int, opts ...request.Option) (rest.PurgeResult, error) {
+	return s.ShardManager.RestClient.PurgeMessages(channelId, filter, limit, opts...)
 }
 
-func (s *Shard) GetChannelInvites(channelId uint64) ([]invite.InviteMetadata, error) {
-	return rest.GetChannelInvites(s.Token, s.ShardManager.RateLimiter, channelId)
+func (s *Shard) EditChannelPermissions(channelId uint64, updated channel.PermissionOverwrite, opts ...request.Option) error {
+	return s.ShardManager.RestClient.EditChannelPermissions(channelId, updated, opts...)
 }
 
-func (s *Shard) CreateChannelInvite(channelId uint64, data rest.CreateInviteData) (invite.Invite, error) {
-	return rest.CreateChannelInvite(s.Token, s.ShardManager.RateLimiter, channelId, data)
+func (s *Shard) GetChannelInvites(channelId uint64, opts ...request.Option) ([]invite.InviteMetadata, error) {
+	return s.ShardManager.RestClient.GetChannelInvites(channelId, opts...)
 }
 
-func (s *Shard) DeleteChannelPermissions(channelId, overwriteId uint64) error {
-	return rest.DeleteChannelPermissions(s.Token, s.ShardManager.RateLimiter, channelId, overwriteId)
+func (s *Shard) CreateChannelInvite(channelId uint64, data rest.CreateInviteData, opts ...request.Option) (invite.Invite, error) {
+	return s.ShardManager.RestClient.CreateChannelInvite(channelId, data, opts...)
 }
 
-func (s *Shard) TriggerTypingIndicator(channelId uint64) error {
-	return rest.TriggerTypingIndicator(s.Token, s.ShardManager.RateLimiter, channelId)
+func (s *Shard) DeleteChannelPermissions(channelId, overwriteId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteChannelPermissions(channelId, overwriteId, opts...)
 }
 
-func (s *Shard) GetPinnedMessages(channelId uint64) ([]message.Message, error) {
-	return rest.GetPinnedMessages(s.Token, s.ShardManager.RateLimiter, channelId)
+func (s *Shard) TriggerTypingIndicator(channelId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.TriggerTypingIndicator(channelId, opts...)
 }
 
-func (s *Shard) AddPinnedChannelMessage(channelId, messageId uint64) error {
-	return rest.AddPinnedChannelMessage(s.Token, s.ShardManager.RateLimiter, channelId, messageId)
+func (s *Shard) GetPinnedMessages(channelId uint64, opts ...request.Option) ([]message.Message, error) {
+	return s.ShardManager.RestClient.GetPinnedMessages(channelId, opts...)
 }
 
-func (s *Shard) DeletePinnedChannelMessage(channelId, messageId uint64) error {
-	return rest.DeletePinnedChannelMessage(s.Token, s.ShardManager.RateLimiter, channelId, messageId)
+func (s *Shard) AddPinnedChannelMessage(channelId, messageId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.AddPinnedChannelMessage(channelId, messageId, opts...)
 }
 
-func (s *Shard) ListGuildEmojis(guildId uint64) ([]emoji.Emoji, error) {
+func (s *Shard) DeletePinnedChannelMessage(channelId, messageId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeletePinnedChannelMessage(channelId, messageId, opts...)
+}
+
+func (s *Shard) ListGuildEmojis(guildId uint64, opts ...request.Option) ([]emoji.Emoji, error) {
 	shouldCacheEmoji := s.Cache.GetOptions().Emojis
 	shouldCacheGuild := s.Cache.GetOptions().Guilds
 
 	if shouldCacheEmoji && shouldCacheGuild {
-		if guild, found := s.Cache.GetGuild(guildId, false); found {
+		lookup := s.startCacheLookup("emojis", opts)
+		guild, found := s.Cache.GetGuild(guildId, false)
+		lookup.end(found)
+		if found {
 			return guild.Emojis, nil
 		}
 	}
 
-	emojis, err := rest.ListGuildEmojis(s.Token, s.ShardManager.RateLimiter, guildId)
+	emojis, err := s.ShardManager.RestClient.ListGuildEmojis(guildId, opts...)
 
 	if shouldCacheEmoji && err == nil {
 		go func() {
@@ -160,15 +191,18 @@ func (s *Shard) ListGuildEmojis(guildId uint64) ([]emoji.Emoji, error) {
 	return emojis, err
 }
 
-func (s *Shard) GetGuildEmoji(guildId uint64, emojiId uint64) (emoji.Emoji, error) {
+func (s *Shard) GetGuildEmoji(guildId uint64, emojiId uint64, opts ...request.Option) (emoji.Emoji, error) {
 	shouldCache := s.Cache.GetOptions().Emojis
 	if shouldCache {
-		if emoji, found := s.Cache.GetEmoji(emojiId); found {
+		lookup := s.startCacheLookup("emoji", opts)
+		emoji, found := s.Cache.GetEmoji(emojiId)
+		lookup.end(found)
+		if found {
 			return emoji, nil
 		}
 	}
 
-	emoji, err := rest.GetGuildEmoji(s.Token, s.ShardManager.RateLimiter, guildId, emojiId)
+	emoji, err := s.ShardManager.RestClient.GetGuildEmoji(guildId, emojiId, opts...)
 
 	if shouldCache && err == nil {
 		go s.Cache.StoreEmoji(emoji, guildId)
@@ -177,29 +211,32 @@ func (s *Shard) GetGuildEmoji(guildId uint64, emojiId uint64) (emoji.Emoji, erro
 	return emoji, err
 }
 
-func (s *Shard) CreateGuildEmoji(guildId uint64, data rest.CreateEmojiData) (emoji.Emoji, error) {
-	return rest.CreateGuildEmoji(s.Token, s.ShardManager.RateLimiter, guildId, data)
+func (s *Shard) CreateGuildEmoji(guildId uint64, data rest.CreateEmojiData, opts ...request.Option) (emoji.Emoji, error) {
+	return s.ShardManager.RestClient.CreateGuildEmoji(guildId, data, opts...)
 }
 
 // updating Image is not permitted
-func (s *Shard) ModifyGuildEmoji(guildId, emojiId uint64, data rest.CreateEmojiData) (emoji.Emoji, error) {
-	return rest.ModifyGuildEmoji(s.Token, s.ShardManager.RateLimiter, guildId, emojiId, data)
+func (s *Shard) ModifyGuildEmoji(guildId, emojiId uint64, data rest.CreateEmojiData, opts ...request.Option) (emoji.Emoji, error) {
+	return s.ShardManager.RestClient.ModifyGuildEmoji(guildId, emojiId, data, opts...)
 }
 
-func (s *Shard) CreateGuild(data rest.CreateGuildData) (guild.Guild, error) {
-	return rest.CreateGuild(s.Token, data)
+func (s *Shard) CreateGuild(data rest.CreateGuildData, opts ...request.Option) (guild.Guild, error) {
+	return s.ShardManager.RestClient.CreateGuild(data, opts...)
 }
 
-func (s *Shard) GetGuild(guildId uint64) (guild.Guild, error) {
+func (s *Shard) GetGuild(guildId uint64, opts ...request.Option) (guild.Guild, error) {
 	shouldCache := s.Cache.GetOptions().Guilds
 
 	if shouldCache {
-		if cachedGuild, found := s.Cache.GetGuild(guildId, false); found {
+		lookup := s.startCacheLookup("guild", opts)
+		cachedGuild, found := s.Cache.GetGuild(guildId, false)
+		lookup.end(found)
+		if found {
 			return cachedGuild, nil
 		}
 	}
 
-	guild, err := rest.GetGuild(s.Token, s.ShardManager.RateLimiter, guildId)
+	guild, err := s.ShardManager.RestClient.GetGuild(guildId, opts...)
 	if err == nil {
 		go s.Cache.StoreGuild(guild)
 	}
@@ -207,23 +244,29 @@ func (s *Shard) GetGuild(guildId uint64) (guild.Guild, error) {
 	return guild, err
 }
 
-func (s *Shard) GetGuildPreview(guildId uint64) (guild.GuildPreview, error) {
-	return rest.GetGuildPreview(s.Token, s.ShardManager.RateLimiter, guildId)
+func (s *Shard) GetGuildPreview(guildId uint64, opts ...request.Option) (guild.GuildPreview, error) {
+	return s.ShardManager.RestClient.GetGuildPreview(guildId, opts...)
 }
 
-func (s *Shard) ModifyGuild(guildId uint64, data rest.ModifyGuildData) (guild.Guild, error) {
-	return rest.ModifyGuild(s.Token, s.ShardManager.RateLimiter, guildId, data)
+func (s *Shard) ModifyGuild(guildId uint64, data rest.ModifyGuildData, opts ...request.Option) (guild.Guild, error) {
+	return s.ShardManager.RestClient.ModifyGuild(guildId, data, opts...)
 }
 
-func (s *Shard) DeleteGuild(guildId uint64) error {
-	return rest.DeleteGuild(s.Token, s.ShardManager.RateLimiter, guildId)
+func (s *Shard) DeleteGuild(guildId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteGuild(guildId, opts...)
 }
 
-func (s *Shard) GetGuildChannels(guildId uint64) ([]channel.Channel, error) {
+func (s *Shard) GetGuildAuditLog(guildId uint64, data rest.GetGuildAuditLogData, opts ...request.Option) (auditlog.AuditLog, error) {
+	return s.ShardManager.RestClient.GetGuildAuditLog(guildId, data, opts...)
+}
+
+func (s *Shard) GetGuildChannels(guildId uint64, opts ...request.Option) ([]channel.Channel, error) {
 	shouldCache := s.Cache.GetOptions().Guilds && s.Cache.GetOptions().Channels
 
 	if shouldCache {
+		lookup := s.startCacheLookup("channels", opts)
 		cached := s.Cache.GetGuildChannels(guildId)
+		lookup.end(len(cached) > 0)
 
 		// either not cached (more likely), or guild has no channels
 		if len(cached) > 0 {
@@ -231,7 +274,7 @@ func (s *Shard) GetGuildChannels(guildId uint64) ([]channel.Channel, error) {
 		}
 	}
 
-	channels, err := rest.GetGuildChannels(s.Token, s.ShardManager.RateLimiter, guildId)
+	channels, err := s.ShardManager.RestClient.GetGuildChannels(guildId, opts...)
 
 	if shouldCache && err == nil {
 		go func() {
@@ -244,25 +287,28 @@ func (s *Shard) GetGuildChannels(guildId uint64) ([]channel.Channel, error) {
 	return channels, err
 }
 
-func (s *Shard) CreateGuildChannel(guildId uint64, data rest.CreateChannelData) (channel.Channel, error) {
-	return rest.CreateGuildChannel(s.Token, s.ShardManager.RateLimiter, guildId, data)
+func (s *Shard) CreateGuildChannel(guildId uint64, data rest.CreateChannelData, opts ...request.Option) (channel.Channel, error) {
+	return s.ShardManager.RestClient.CreateGuildChannel(guildId, data, opts...)
 }
 
-func (s *Shard) ModifyGuildChannelPositions(guildId uint64, positions []rest.Position) error {
-	return rest.ModifyGuildChannelPositions(s.Token, s.ShardManager.RateLimiter, guildId, positions)
+func (s *Shard) ModifyGuildChannelPositions(guildId uint64, positions []rest.Position, opts ...request.Option) error {
+	return s.ShardManager.RestClient.ModifyGuildChannelPositions(guildId, positions, opts...)
 }
 
-func (s *Shard) GetGuildMember(guildId, userId uint64) (member.Member, error) {
+func (s *Shard) GetGuildMember(guildId, userId uint64, opts ...request.Option) (member.Member, error) {
 	cacheGuilds := s.Cache.GetOptions().Guilds
 	cacheUsers := s.Cache.GetOptions().Users
 
 	if cacheGuilds && cacheUsers {
-		if member, found := s.Cache.GetMember(guildId, userId); found {
+		lookup := s.startCacheLookup("member", opts)
+		member, found := s.Cache.GetMember(guildId, userId)
+		lookup.end(found)
+		if found {
 			return member, nil
 		}
 	}
 
-	member, err := rest.GetGuildMember(s.Token, s.ShardManager.RateLimiter, guildId, userId)
+	member, err := s.ShardManager.RestClient.GetGuildMember(guildId, userId, opts...)
 
 	if cacheGuilds && err == nil {
 		go s.Cache.StoreMember(member, guildId)
@@ -271,8 +317,8 @@ func (s *Shard) GetGuildMember(guildId, userId uint64) (member.Member, error) {
 	return member, err
 }
 
-func (s *Shard) ListGuildMembers(guildId uint64, data rest.ListGuildMembersData) ([]member.Member, error) {
-	members, err := rest.ListGuildMembers(s.Token, s.ShardManager.RateLimiter, guildId, data)
+func (s *Shard) ListGuildMembers(guildId uint64, data rest.ListGuildMembersData, opts ...request.Option) ([]member.Member, error) {
+	members, err := s.ShardManager.RestClient.ListGuildMembers(guildId, data, opts...)
 	if err == nil {
 		go func() {
 			for _, member := range members {
@@ -284,46 +330,52 @@ func (s *Shard) ListGuildMembers(guildId uint64, data rest.ListGuildMembersData)
 	return members, err
 }
 
-func (s *Shard) ModifyGuildMember(guildId, userId uint64, data rest.ModifyGuildMemberData) error {
-	return rest.ModifyGuildMember(s.Token, s.ShardManager.RateLimiter, guildId, userId, data)
+func (s *Shard) MemberIterator(guildId uint64, options rest.IteratorOptions, opts ...request.Option) *rest.MemberIterator {
+	return s.ShardManager.RestClient.MemberIterator(guildId, options, opts...)
+}
+
+func (s *Shard) ModifyGuildMember(guildId, userId uint64, data rest.ModifyGuildMemberData, opts ...request.Option) error {
+	return s.ShardManager.RestClient.ModifyGuildMember(guildId, userId, data, opts...)
 }
 
-func (s *Shard) ModifyCurrentUserNick(guildId uint64, nick string) error {
-	return rest.ModifyCurrentUserNick(s.Token, s.ShardManager.RateLimiter, guildId, nick)
+func (s *Shard) ModifyCurrentUserNick(guildId uint64, nick string, opts ...request.Option) error {
+	return s.ShardManager.RestClient.ModifyCurrentUserNick(guildId, nick, opts...)
 }
 
-func (s *Shard) AddGuildMemberRole(guildId, userId, roleId uint64) error {
-	return rest.AddGuildMemberRole(s.Token, s.ShardManager.RateLimiter, guildId, userId, roleId)
+func (s *Shard) AddGuildMemberRole(guildId, userId, roleId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.AddGuildMemberRole(guildId, userId, roleId, opts...)
 }
 
-func (s *Shard) RemoveGuildMemberRole(guildId, userId, roleId uint64) error {
-	return rest.RemoveGuildMemberRole(s.Token, s.ShardManager.RateLimiter, guildId, userId, roleId)
+func (s *Shard) RemoveGuildMemberRole(guildId, userId, roleId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.RemoveGuildMemberRole(guildId, userId, roleId, opts...)
 }
 
-func (s *Shard) RemoveGuildMember(guildId, userId uint64) error {
-	return rest.RemoveGuildMember(s.Token, s.ShardManager.RateLimiter, guildId, userId)
+func (s *Shard) RemoveGuildMember(guildId, userId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.RemoveGuildMember(guildId, userId, opts...)
 }
 
-func (s *Shard) GetGuildBans(guildId uint64) ([]guild.Ban, error) {
-	return rest.GetGuildBans(s.Token, s.ShardManager.RateLimiter, guildId)
+func (s *Shard) GetGuildBans(guildId uint64, opts ...request.Option) ([]guild.Ban, error) {
+	return s.ShardManager.RestClient.GetGuildBans(guildId, opts...)
 }
 
-func (s *Shard) GetGuildBan(guildId, userId uint64) (guild.Ban, error) {
-	return rest.GetGuildBan(s.Token, s.ShardManager.RateLimiter, guildId, userId)
+func (s *Shard) GetGuildBan(guildId, userId uint64, opts ...request.Option) (guild.Ban, error) {
+	return s.ShardManager.RestClient.GetGuildBan(guildId, userId, opts...)
 }
 
-func (s *Shard) CreateGuildBan(guildId, userId uint64, data rest.CreateGuildBanData) error {
-	return rest.CreateGuildBan(s.Token, s.ShardManager.RateLimiter, guildId, userId, data)
+func (s *Shard) CreateGuildBan(guildId, userId uint64, data rest.CreateGuildBanData, opts ...request.Option) error {
+	return s.ShardManager.RestClient.CreateGuildBan(guildId, userId, data, opts...)
 }
 
-func (s *Shard) RemoveGuildBan(guildId, userId uint64) error {
-	return rest.RemoveGuildBan(s.Token, s.ShardManager.RateLimiter, guildId, userId)
+func (s *Shard) RemoveGuildBan(guildId, userId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.RemoveGuildBan(guildId, userId, opts...)
 }
 
-func (s *Shard) GetGuildRoles(guildId uint64) ([]guild.Role, error) {
+func (s *Shard) GetGuildRoles(guildId uint64, opts ...request.Option) ([]guild.Role, error) {
 	shouldCache := s.Cache.GetOptions().Guilds
 	if shouldCache {
+		lookup := s.startCacheLookup("roles", opts)
 		cached := s.Cache.GetGuildRoles(guildId)
+		lookup.end(len(cached) > 0)
 
 		// either not cached (more likely), or guild has no channels
 		if len(cached) > 0 {
@@ -331,7 +383,7 @@ func (s *Shard) GetGuildRoles(guildId uint64) ([]guild.Role, error) {
 		}
 	}
 
-	roles, err := rest.GetGuildRoles(s.Token, s.ShardManager.RateLimiter, guildId)
+	roles, err := s.ShardManager.RestClient.GetGuildRoles(guildId, opts...)
 
 	if shouldCache && err == nil {
 		go func() {
@@ -344,90 +396,93 @@ func (s *Shard) GetGuildRoles(guildId uint64) ([]guild.Role, error) {
 	return roles, err
 }
 
-func (s *Shard) CreateGuildRole(guildId uint64, data rest.GuildRoleData) (guild.Role, error) {
-	return rest.CreateGuildRole(s.Token, s.ShardManager.RateLimiter, guildId, data)
+func (s *Shard) CreateGuildRole(guildId uint64, data rest.GuildRoleData, opts ...request.Option) (guild.Role, error) {
+	return s.ShardManager.RestClient.CreateGuildRole(guildId, data, opts...)
 }
 
-func (s *Shard) ModifyGuildRolePositions(guildId uint64, positions []rest.Position) ([]guild.Role, error) {
-	return rest.ModifyGuildRolePositions(s.Token, s.ShardManager.RateLimiter, guildId, positions)
+func (s *Shard) ModifyGuildRolePositions(guildId uint64, positions []rest.Position, opts ...request.Option) ([]guild.Role, error) {
+	return s.ShardManager.RestClient.ModifyGuildRolePositions(guildId, positions, opts...)
 }
 
-func (s *Shard) ModifyGuildRole(guildId, roleId uint64, data rest.GuildRoleData) (guild.Role, error) {
-	return rest.ModifyGuildRole(s.Token, s.ShardManager.RateLimiter, guildId, roleId, data)
+func (s *Shard) ModifyGuildRole(guildId, roleId uint64, data rest.GuildRoleData, opts ...request.Option) (guild.Role, error) {
+	return s.ShardManager.RestClient.ModifyGuildRole(guildId, roleId, data, opts...)
 }
 
-func (s *Shard) DeleteGuildRole(guildId, roleId uint64) error {
-	return rest.DeleteGuildRole(s.Token, s.ShardManager.RateLimiter, guildId, roleId)
+func (s *Shard) DeleteGuildRole(guildId, roleId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteGuildRole(guildId, roleId, opts...)
 }
 
-func (s *Shard) GetGuildPruneCount(guildId uint64, days int) (int, error) {
-	return rest.GetGuildPruneCount(s.Token, s.ShardManager.RateLimiter, guildId, days)
+func (s *Shard) GetGuildPruneCount(guildId uint64, days int, opts ...request.Option) (int, error) {
+	return s.ShardManager.RestClient.GetGuildPruneCount(guildId, days, opts...)
 }
 
 // computePruneCount = whether 'pruned' is returned, discouraged for large guilds
-func (s *Shard) BeginGuildPrune(guildId uint64, days int, computePruneCount bool) error {
-	return rest.BeginGuildPrune(s.Token, s.ShardManager.RateLimiter, guildId, days, computePruneCount)
+func (s *Shard) BeginGuildPrune(guildId uint64, days int, computePruneCount bool, opts ...request.Option) error {
+	return s.ShardManager.RestClient.BeginGuildPrune(guildId, days, computePruneCount, opts...)
 }
 
-func (s *Shard) GetGuildVoiceRegions(guildId uint64) ([]guild.VoiceRegion, error) {
-	return rest.GetGuildVoiceRegions(s.Token, s.ShardManager.RateLimiter, guildId)
+func (s *Shard) GetGuildVoiceRegions(guildId uint64, opts ...request.Option) ([]guild.VoiceRegion, error) {
+	return s.ShardManager.RestClient.GetGuildVoiceRegions(guildId, opts...)
 }
 
-func (s *Shard) GetGuildInvites(guildId uint64) ([]invite.InviteMetadata, error) {
-	return rest.GetGuildInvites(s.Token, s.ShardManager.RateLimiter, guildId)
+func (s *Shard) GetGuildInvites(guildId uint64, opts ...request.Option) ([]invite.InviteMetadata, error) {
+	return s.ShardManager.RestClient.GetGuildInvites(guildId, opts...)
 }
 
-func (s *Shard) GetGuildIntegrations(guildId uint64) ([]integration.Integration, error) {
-	return rest.GetGuildIntegrations(s.Token, s.ShardManager.RateLimiter, guildId)
+func (s *Shard) GetGuildIntegrations(guildId uint64, opts ...request.Option) ([]integration.Integration, error) {
+	return s.ShardManager.RestClient.GetGuildIntegrations(guildId, opts...)
 }
 
-func (s *Shard) CreateGuildIntegration(guildId uint64, data rest.CreateIntegrationData) error {
-	return rest.CreateGuildIntegration(s.Token, s.ShardManager.RateLimiter, guildId, data)
+func (s *Shard) CreateGuildIntegration(guildId uint64, data rest.CreateIntegrationData, opts ...request.Option) error {
+	return s.ShardManager.RestClient.CreateGuildIntegration(guildId, data, opts...)
 }
 
-func (s *Shard) ModifyGuildIntegration(guildId, integrationId uint64, data rest.ModifyIntegrationData) error {
-	return rest.ModifyGuildIntegration(s.Token, s.ShardManager.RateLimiter, guildId, integrationId, data)
+func (s *Shard) ModifyGuildIntegration(guildId, integrationId uint64, data rest.ModifyIntegrationData, opts ...request.Option) error {
+	return s.ShardManager.RestClient.ModifyGuildIntegration(guildId, integrationId, data, opts...)
 }
 
-func (s *Shard) DeleteGuildIntegration(guildId, integrationId uint64) error {
-	return rest.DeleteGuildIntegration(s.Token, s.ShardManager.RateLimiter, guildId, integrationId)
+func (s *Shard) DeleteGuildIntegration(guildId, integrationId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteGuildIntegration(guildId, integrationId, opts...)
 }
 
-func (s *Shard) SyncGuildIntegration(guildId, integrationId uint64) error {
-	return rest.SyncGuildIntegration(s.Token, s.ShardManager.RateLimiter, guildId, integrationId)
+func (s *Shard) SyncGuildIntegration(guildId, integrationId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.SyncGuildIntegration(guildId, integrationId, opts...)
 }
 
-func (s *Shard) GetGuildEmbed(guildId uint64) (guild.GuildEmbed, error) {
-	return rest.GetGuildEmbed(s.Token, s.ShardManager.RateLimiter, guildId)
+func (s *Shard) GetGuildEmbed(guildId uint64, opts ...request.Option) (guild.GuildEmbed, error) {
+	return s.ShardManager.RestClient.GetGuildEmbed(guildId, opts...)
 }
 
-func (s *Shard) ModifyGuildEmbed(guildId uint64, data guild.GuildEmbed) (guild.GuildEmbed, error) {
-	return rest.ModifyGuildEmbed(s.Token, s.ShardManager.RateLimiter, guildId, data)
+func (s *Shard) ModifyGuildEmbed(guildId uint64, data guild.GuildEmbed, opts ...request.Option) (guild.GuildEmbed, error) {
+	return s.ShardManager.RestClient.ModifyGuildEmbed(guildId, data, opts...)
 }
 
 // returns invite object with only "code" and "uses" fields
-func (s *Shard) GetGuildVanityUrl(guildId uint64) (invite.Invite, error) {
-	return rest.GetGuildVanityURL(s.Token, s.ShardManager.RateLimiter, guildId)
+func (s *Shard) GetGuildVanityUrl(guildId uint64, opts ...request.Option) (invite.Invite, error) {
+	return s.ShardManager.RestClient.GetGuildVanityURL(guildId, opts...)
 }
 
-func (s *Shard) GetGuildWidgetImage(guildId uint64, style guild.WidgetStyle) (image.Image, error) {
-	return rest.GetGuildWidgetImage(s.Token, s.ShardManager.RateLimiter, guildId, style)
+func (s *Shard) GetGuildWidgetImage(guildId uint64, style guild.WidgetStyle, opts ...request.Option) (image.Image, error) {
+	return s.ShardManager.RestClient.GetGuildWidgetImage(guildId, style, opts...)
 }
 
-func (s *Shard) GetInvite(inviteCode string, withCounts bool) (invite.Invite, error) {
-	return rest.GetInvite(s.Token, s.ShardManager.RateLimiter, inviteCode, withCounts)
+func (s *Shard) GetInvite(inviteCode string, withCounts bool, opts ...request.Option) (invite.Invite, error) {
+	return s.ShardManager.RestClient.GetInvite(inviteCode, withCounts, opts...)
 }
 
-func (s *Shard) DeleteInvite(inviteCode string) (invite.Invite, error) {
-	return rest.DeleteInvite(s.Token, s.ShardManager.RateLimiter, inviteCode)
+func (s *Shard) DeleteInvite(inviteCode string, opts ...request.Option) (invite.Invite, error) {
+	return s.ShardManager.RestClient.DeleteInvite(inviteCode, opts...)
 }
 
-func (s *Shard) GetCurrentUser() (user.User, error) {
-	if cached, found := s.Cache.GetSelf(); found {
+func (s *Shard) GetCurrentUser(opts ...request.Option) (user.User, error) {
+	lookup := s.startCacheLookup("self", opts)
+	cached, found := s.Cache.GetSelf()
+	lookup.end(found)
+	if found {
 		return cached, nil
 	}
 
-	self, err := rest.GetCurrentUser(s.Token, s.ShardManager.RateLimiter)
+	self, err := s.ShardManager.RestClient.GetCurrentUser(opts...)
 
 	if err == nil {
 		go s.Cache.StoreSelf(self)
@@ -436,16 +491,19 @@ func (s *Shard) GetCurrentUser() (user.User, error) {
 	return self, err
 }
 
-func (s *Shard) GetUser(userId uint64) (user.User, error) {
+func (s *Shard) GetUser(userId uint64, opts ...request.Option) (user.User, error) {
 	shouldCache := s.Cache.GetOptions().Users
 
 	if shouldCache {
-		if cached, found := s.Cache.GetUser(userId); found {
+		lookup := s.startCacheLookup("user", opts)
+		cached, found := s.Cache.GetUser(userId)
+		lookup.end(found)
+		if found {
 			return cached, nil
 		}
 	}
 
-	user, err := rest.GetUser(s.Token, s.ShardManager.RateLimiter, userId)
+	user, err := s.ShardManager.RestClient.GetUser(userId, opts...)
 
 	if shouldCache && err == nil {
 		go s.Cache.StoreUser(user)
@@ -454,56 +512,73 @@ func (s *Shard) GetUser(userId uint64) (user.User, error) {
 	return user, err
 }
 
-func (s *Shard) ModifyCurrentUser(data rest.ModifyUserData) (user.User, error) {
-	return rest.ModifyCurrentUser(s.Token, s.ShardManager.RateLimiter, data)
+func (s *Shard) ModifyCurrentUser(data rest.ModifyUserData, opts ...request.Option) (user.User, error) {
+	return s.ShardManager.RestClient.ModifyCurrentUser(data, opts...)
 }
 
-func (s *Shard) GetCurrentUserGuilds(data rest.CurrentUserGuildsData) ([]guild.Guild, error) {
-	return rest.GetCurrentUserGuilds(s.Token, s.ShardManager.RateLimiter, data)
+func (s *Shard) GetCurrentUserGuilds(data rest.CurrentUserGuildsData, opts ...request.Option) ([]guild.Guild, error) {
+	return s.ShardManager.RestClient.GetCurrentUserGuilds(data, opts...)
 }
 
-func (s *Shard) LeaveGuild(guildId uint64) error {
-	return rest.LeaveGuild(s.Token, s.ShardManager.RateLimiter, guildId)
+func (s *Shard) CurrentUserGuildIterator(options rest.IteratorOptions, opts ...request.Option) *rest.CurrentUserGuildIterator {
+	return s.ShardManager.RestClient.CurrentUserGuildIterator(options, opts...)
 }
 
-func (s *Shard) CreateDM(recipientId uint64) (channel.Channel, error) {
-	return rest.CreateDM(s.Token, s.ShardManager.RateLimiter, recipientId)
+func (s *Shard) LeaveGuild(guildId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.LeaveGuild(guildId, opts...)
 }
 
-func (s *Shard) GetUserConnections() ([]integration.Connection, error) {
-	return rest.GetUserConnections(s.Token, s.ShardManager.RateLimiter)
+func (s *Shard) CreateDM(recipientId uint64, opts ...request.Option) (channel.Channel, error) {
+	return s.ShardManager.RestClient.CreateDM(recipientId, opts...)
+}
+
+func (s *Shard) GetUserConnections(opts ...request.Option) ([]integration.Connection, error) {
+	return s.ShardManager.RestClient.GetUserConnections(opts...)
 }
 
 // GetGuildVoiceRegions should be preferred, as it returns VIP servers if available to the guild
-func (s *Shard) ListVoiceRegions() ([]guild.VoiceRegion, error) {
-	return rest.ListVoiceRegions(s.Token)
+func (s *Shard) ListVoiceRegions(opts ...request.Option) ([]guild.VoiceRegion, error) {
+	return s.ShardManager.RestClient.ListVoiceRegions(opts...)
 }
 
-func (s *Shard) CreateWebhook(channelId uint64, data rest.WebhookData) (guild.Webhook, error) {
-	return rest.CreateWebhook(s.Token, s.ShardManager.RateLimiter, channelId, data)
+func (s *Shard) CreateWebhook(channelId uint64, data rest.WebhookData, opts ...request.Option) (guild.Webhook, error) {
+	return s.ShardManager.RestClient.CreateWebhook(channelId, data, opts...)
 }
 
-func (s *Shard) GetChannelWebhooks(channelId uint64) ([]guild.Webhook, error) {
-	return rest.GetChannelWebhooks(s.Token, s.ShardManager.RateLimiter, channelId)
+func (s *Shard) GetChannelWebhooks(channelId uint64, opts ...request.Option) ([]guild.Webhook, error) {
+	return s.ShardManager.RestClient.GetChannelWebhooks(channelId, opts...)
 }
 
-func (s *Shard) GetGuildWebhooks(guildId uint64) ([]guild.Webhook, error) {
-	return rest.GetGuildWebhooks(s.Token, s.ShardManager.RateLimiter, guildId)
+func (s *Shard) GetGuildWebhooks(guildId uint64, opts ...request.Option) ([]guild.Webhook, error) {
+	return s.ShardManager.RestClient.GetGuildWebhooks(guildId, opts...)
 }
 
-func (s *Shard) GetWebhook(webhookId uint64) (guild.Webhook, error) {
-	return rest.GetWebhook(s.Token, s.ShardManager.RateLimiter, webhookId)
+func (s *Shard) GetWebhook(webhookId uint64, opts ...request.Option) (guild.Webhook, error) {
+	return s.ShardManager.RestClient.GetWebhook(webhookId, opts...)
 }
 
-func (s *Shard) ModifyWebhook(webhookId uint64, data rest.ModifyWebhookData) (guild.Webhook, error) {
-	return rest.ModifyWebhook(s.Token, s.ShardManager.RateLimiter, webhookId, data)
+func (s *Shard) ModifyWebhook(webhookId uint64, data rest.ModifyWebhookData, opts ...request.Option) (guild.Webhook, error) {
+	return s.ShardManager.RestClient.ModifyWebhook(webhookId, data, opts...)
 }
 
-func (s *Shard) DeleteWebhook(webhookId uint64) error {
-	return rest.DeleteWebhook(s.Token, s.ShardManager.RateLimiter, webhookId)
+func (s *Shard) DeleteWebhook(webhookId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteWebhook(webhookId, opts...)
 }
 
 // if wait=true, a message object will be returned
-func (s *Shard) ExecuteWebhook(webhookId uint64, webhookToken string, wait bool, data rest.WebhookBody) (*message.Message, error) {
-	return rest.ExecuteWebhook(webhookToken, s.ShardManager.RateLimiter, webhookId, wait, data)
+func (s *Shard) ExecuteWebhook(webhookId uint64, webhookToken string, wait bool, data rest.WebhookBody, opts ...request.Option) (*message.Message, error) {
+	return s.ShardManager.RestClient.ExecuteWebhook(webhookId, webhookToken, wait, data, opts...)
+}
+
+func (s *Shard) EditWebhookMessage(webhookId uint64, webhookToken string, messageId uint64, data rest.WebhookEditBody, opts ...request.Option) (message.Message, error) {
+	return s.ShardManager.RestClient.EditWebhookMessage(webhookId, webhookToken, messageId, data, opts...)
+}
+
+func (s *Shard) DeleteWebhookMessage(webhookId uint64, webhookToken string, messageId uint64, opts ...request.Option) error {
+	return s.ShardManager.RestClient.DeleteWebhookMessage(webhookId, webhookToken, messageId, opts...)
+}
+
+// DownloadAsset fetches a CDN asset, e.g. so that it can be uploaded again with rest.NewImage
+func (s *Shard) DownloadAsset(url string, opts ...request.Option) ([]byte, error) {
+	return s.ShardManager.RestClient.DownloadAsset(url, opts...)
 }
@@ -9,15 +9,15 @@ import (
 	"github.com/rxdn/gdl/cache"
 	"github.com/rxdn/gdl/gateway/payloads"
 	"github.com/rxdn/gdl/gateway/payloads/events"
+	"github.com/rxdn/gdl/logging"
 	"github.com/rxdn/gdl/objects/user"
 	"github.com/rxdn/gdl/utils"
-	"github.com/sirupsen/logrus"
 	"github.com/tatsuworks/czlib"
-	"log"
 	"net/http"
 	"nhooyr.io/websocket"
 	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -45,9 +45,14 @@ type Shard struct {
 	heartbeatLock                sync.RWMutex
 	killHeartbeat                chan struct{}
 
-	sessionId string
+	sessionLock sync.RWMutex
+	sessionId   string
 
-	Cache cache.Cache
+	lastEvent    int64 // Millis, accessed atomically
+	guildTracker *guildTracker
+
+	Cache  cache.Cache
+	Logger logging.Logger
 }
 
 func NewShard(shardManager *ShardManager, token string, shardId int) Shard {
@@ -61,20 +66,22 @@ func NewShard(shardManager *ShardManager, token string, shardId int) Shard {
 		context:                      context.Background(),
 		lastHeartbeatAcknowledgement: utils.GetCurrentTimeMillis(),
 		Cache:                        cache,
+		Logger:                       shardManager.ShardOptions.Logger.With(logging.ShardId(shardId)),
+		guildTracker:                 newGuildTracker(),
 		readLock:                     &sync.Mutex{},
 	}
 }
 
 func (s *Shard) EnsureConnect() {
 	if err := s.Connect(); err != nil {
-		logrus.Warnf("shard %d: Error whilst connecting: %s", s.ShardId, err.Error())
+		s.Logger.Warn("Error whilst connecting", logging.Err(err))
 		time.Sleep(500 * time.Millisecond)
 		s.EnsureConnect()
 	}
 }
 
 func (s *Shard) Connect() error {
-	logrus.Infof("shard %d: Starting", s.ShardId)
+	s.Logger.Info("Starting")
 
 	// Connect to Discord
 	s.stateLock.Lock() // Can't RLock - potential state issue
@@ -88,9 +95,17 @@ func (s *Shard) Connect() error {
 		s.stateLock.Lock()
 	}
 
-	s.state = CONNECTING
+	s.setState(CONNECTING)
 	s.stateLock.Unlock()
 
+	s.sequenceLock.RLock()
+	hasConnected := s.sequenceNumber != nil
+	s.sequenceLock.RUnlock()
+
+	if hasConnected {
+		s.ShardManager.ShardOptions.Metrics.Reconnect(s.ShardId)
+	}
+
 	// initialise zlib reader
 	zlibReader, err := czlib.NewReader(bytes.NewReader(nil))
 	if err != nil {
@@ -113,7 +128,7 @@ func (s *Shard) Connect() error {
 
 	if err != nil {
 		s.stateLock.Lock()
-		s.state = DEAD
+		s.setState(DEAD)
 		s.stateLock.Unlock()
 		return err
 	}
@@ -124,21 +139,29 @@ func (s *Shard) Connect() error {
 
 	// Read hello
 	if err := s.read(); err != nil {
-		logrus.Warnf("shard %d: Error whilst reading Hello: %s", s.ShardId, err.Error())
+		s.Logger.Warn("Error whilst reading Hello", logging.Err(err))
 		s.Kill()
 		return err
 	}
 
-	if s.sessionId == "" || s.sequenceNumber == nil {
+	s.sessionLock.RLock()
+	hasSession := s.sessionId != ""
+	s.sessionLock.RUnlock()
+
+	s.sequenceLock.RLock()
+	hasSequence := s.sequenceNumber != nil
+	s.sequenceLock.RUnlock()
+
+	if !hasSession || !hasSequence {
 		s.identify()
 	} else {
 		s.resume()
 	}
 
-	logrus.Infof("shard %d: Connected", s.ShardId)
+	s.Logger.Info("Connected")
 
 	s.stateLock.Lock()
-	s.state = CONNECTED
+	s.setState(CONNECTED)
 	s.stateLock.Unlock()
 
 	go func() {
@@ -153,7 +176,7 @@ func (s *Shard) Connect() error {
 
 			// Read
 			if err := s.read(); err != nil {
-				logrus.Warnf("shard %d: Error whilst reading payload: %s", s.ShardId, err.Error())
+				s.Logger.Warn("Error whilst reading payload", logging.Err(err))
 
 				s.stateLock.Lock()
 				state := s.state
@@ -176,6 +199,9 @@ func (s *Shard) identify() {
 		s.ShardManager.ShardOptions.Hooks.IdentifyHook(s)
 	}
 
+	// we will receive a new READY
+	s.guildTracker.reset()
+
 	// build payload
 	identify := payloads.NewIdentify(
 		s.ShardId,
@@ -188,24 +214,29 @@ func (s *Shard) identify() {
 
 	// wait for ratelimit
 	if err := s.ShardManager.RateLimiter.IdentifyWait(s.ShardId); err != nil {
-		logrus.Warnf("shard %d: Error whilst waiting on identify ratelimit: %s", s.ShardId, err.Error())
+		s.Logger.Warn("Error whilst waiting on identify ratelimit", logging.Err(err))
 	}
 
 	if err := s.write(identify); err != nil {
-		logrus.Warnf("shard %d: Error whilst sending Identify: %s", s.ShardId, err.Error())
+		s.Logger.Warn("Error whilst sending Identify", logging.Err(err))
 		s.identify()
 	}
 }
 
 func (s *Shard) resume() {
+	s.sessionLock.RLock()
+	sessionId := s.sessionId
+	s.sessionLock.RUnlock()
+
 	s.sequenceLock.RLock()
-	resume := payloads.NewResume(s.Token, s.sessionId, *s.sequenceNumber)
+	resume := payloads.NewResume(s.Token, sessionId, *s.sequenceNumber)
 	s.sequenceLock.RUnlock()
 
-	logrus.Infof("shard %d: Resuming", s.ShardId)
+	s.Logger.Info("Resuming")
+	s.ShardManager.ShardOptions.Metrics.Resume(s.ShardId)
 
 	if err := s.write(resume); err != nil {
-		logrus.Warnf("shard %d: Error whilst sending Resume: %s", s.ShardId, err.Error())
+		s.Logger.Warn("Error whilst sending Resume", logging.Err(err))
 		s.identify()
 	}
 }
@@ -213,7 +244,7 @@ func (s *Shard) resume() {
 func (s *Shard) read() error {
 	defer func() {
 		if r := recover(); r != nil {
-			logrus.Warnf("Recovered panic while reading: %s", r)
+			s.Logger.Warn("Recovered panic while reading", logging.Any("panic", r))
 			s.Kill()
 			go s.EnsureConnect()
 		}
@@ -238,11 +269,21 @@ func (s *Shard) read() error {
 	case 0: // Event
 		{
 			event := events.EventType(payload.EventName)
-			go s.ExecuteEvent(event, payload.Data)
+			s.ShardManager.ShardOptions.Metrics.EventReceived(s.ShardId, payload.EventName)
+			atomic.StoreInt64(&s.lastEvent, utils.GetCurrentTimeMillis())
+
+			// synthetic events are executed after the raw event, so listeners see them in order
+			synthetic := s.trackGuilds(event, payload.Data)
+			go func() {
+				s.ExecuteEvent(event, payload.Data)
+				for _, e := range synthetic {
+					s.ExecuteEvent(e.eventType, e.data)
+				}
+			}()
 		}
 	case 7: // Reconnect
 		{
-			logrus.Infof("shard %d: received reconnect payload from discord", s.ShardId)
+			s.Logger.Info("Received reconnect payload from discord")
 
 			if s.ShardManager.ShardOptions.Hooks.ReconnectHook != nil {
 				s.ShardManager.ShardOptions.Hooks.ReconnectHook(s)
@@ -253,9 +294,13 @@ func (s *Shard) read() error {
 		}
 	case 9: // Invalid session
 		{
-			logrus.Infof("shard %d: received invalid session payload from discord", s.ShardId)
+			s.Logger.Info("Received invalid session payload from discord")
 			s.Kill()
+
+			s.sessionLock.Lock()
 			s.sessionId = ""
+			s.sessionLock.Unlock()
+
 			go s.EnsureConnect()
 		}
 	case 10: // Hello
@@ -275,13 +320,16 @@ func (s *Shard) read() error {
 		{
 			_, err := payloads.NewHeartbeackAck(data)
 			if err != nil {
-				log.Println(err.Error())
+				s.Logger.Warn("Error whilst decoding heartbeat ACK", logging.Err(err))
 				return err
 			}
 
 			s.heartbeatLock.Lock()
 			s.lastHeartbeatAcknowledgement = utils.GetCurrentTimeMillis()
+			latency := s.lastHeartbeatAcknowledgement - s.lastHeartbeat
 			s.heartbeatLock.Unlock()
+
+			s.ShardManager.ShardOptions.Metrics.HeartbeatLatency(s.ShardId, time.Duration(latency)*time.Millisecond)
 		}
 	}
 
@@ -319,9 +367,8 @@ func (s *Shard) write(payload interface{}) error {
 
 func (s *Shard) writeRaw(data []byte) error {
 	if s.WebSocket == nil {
-		msg := fmt.Sprintf("shard %d: WS is closed", s.ShardId)
-		logrus.Warn(msg)
-		return errors.New(msg)
+		s.Logger.Warn("WS is closed")
+		return fmt.Errorf("shard %d: WS is closed", s.ShardId)
 	}
 
 	err := s.WebSocket.Write(s.context, websocket.MessageText, data)
@@ -334,18 +381,18 @@ func (s *Shard) Kill() error {
 		debug.PrintStack()
 	}
 
-	logrus.Infof("killing shard %d", s.ShardId)
+	s.Logger.Info("Killing shard")
 
 	go func() {
 		s.killHeartbeat <- struct{}{}
 	}()
 
 	if err := s.zLibReader.Close(); err != nil {
-		logrus.Warnf("shard %d: error closing zlib: %s", s.ShardId, err.Error())
+		s.Logger.Warn("Error closing zlib", logging.Err(err))
 	}
 
 	s.stateLock.Lock()
-	s.state = DISCONNECTING
+	s.setState(DISCONNECTING)
 
 	var err error
 	if s.WebSocket != nil {
@@ -354,14 +401,20 @@ func (s *Shard) Kill() error {
 
 	s.WebSocket = nil
 
-	s.state = DEAD
+	s.setState(DEAD)
 	s.stateLock.Unlock()
 
-	logrus.Infof("killed shard %d", s.ShardId)
+	s.Logger.Info("Killed shard")
 
 	return err
 }
 
+// stateLock must be held by the caller
+func (s *Shard) setState(state State) {
+	s.state = state
+	s.ShardManager.ShardOptions.Metrics.ShardState(s.ShardId, state.String())
+}
+
 func (s *Shard) UpdateStatus(data user.UpdateStatus) error {
 	return s.write(payloads.NewPresenceUpdate(data))
 }
@@ -2,17 +2,24 @@ package gateway
 
 import (
 	"github.com/rxdn/gdl/gateway/payloads/events"
+	"github.com/rxdn/gdl/logging"
+	"github.com/rxdn/gdl/metrics"
+	"github.com/rxdn/gdl/rest"
 	"github.com/rxdn/gdl/rest/ratelimit"
 	"github.com/rxdn/gdl/rest/request"
+	"github.com/rxdn/gdl/tracing"
+	"github.com/rxdn/gdl/utils"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 type ShardManager struct {
 	Token string
 
 	RateLimiter *ratelimit.Ratelimiter
+	RestClient  *rest.Client
 
 	ShardOptions ShardOptions
 	Shards       map[int]*Shard
@@ -25,6 +32,22 @@ func NewShardManager(token string, shardOptions ShardOptions) *ShardManager {
 		shardOptions.LargeShardingBuckets = 1
 	}
 
+	if shardOptions.Metrics == nil {
+		shardOptions.Metrics = metrics.NoopMetrics{}
+	}
+
+	if shardOptions.Logger == nil {
+		shardOptions.Logger = logging.StandardLogrusLogger()
+	}
+
+	if shardOptions.Tracer == nil {
+		shardOptions.Tracer = tracing.NoopTracer{}
+	}
+
+	if shardOptions.GuildReadyTimeout == 0 {
+		shardOptions.GuildReadyTimeout = time.Second * 15
+	}
+
 	manager := &ShardManager{
 		Token:        token,
 		RateLimiter:  ratelimit.NewRateLimiter(shardOptions.RateLimitStore, shardOptions.LargeShardingBuckets),
@@ -38,7 +61,24 @@ func NewShardManager(token string, shardOptions ShardOptions) *ShardManager {
 		manager.Shards[i] = &shard
 	}
 
-	request.Hook = shardOptions.Hooks.RestHook
+	manager.RateLimiter.Metrics = shardOptions.Metrics
+	manager.RateLimiter.Tracer = shardOptions.Tracer
+	manager.RateLimiter.GlobalLimit = shardOptions.GlobalRateLimit
+
+	manager.RestClient = rest.NewClient(token, manager.RateLimiter)
+	if shardOptions.RetryPolicy != nil {
+		manager.RestClient.RetryPolicy = shardOptions.RetryPolicy
+	}
+
+	manager.RestClient.Hook = shardOptions.Hooks.RestHook
+	manager.RestClient.AllowedMentions = shardOptions.AllowedMentions
+	manager.RestClient.Logger = shardOptions.Logger
+	manager.RestClient.Metrics = shardOptions.Metrics
+	manager.RestClient.Tracer = shardOptions.Tracer
+	if shardOptions.RestProxyUrl != "" {
+		manager.RestClient.BaseUrl = request.ProxyBaseUrl(shardOptions.RestProxyUrl)
+		manager.RestClient.Token = shardOptions.RestProxyToken
+	}
 
 	RegisterCacheListeners(manager)
 
@@ -58,7 +98,7 @@ func (sm *ShardManager) RegisterListeners(listeners ...interface{}) {
 }
 
 func (sm *ShardManager) ShardForGuild(guildId uint64) *Shard {
-	shardId := int((guildId >> 22) % uint64(sm.ShardOptions.ShardCount.Total))
+	shardId := int(utils.Snowflake(guildId).Timestamp() % uint64(sm.ShardOptions.ShardCount.Total))
 	return sm.Shards[shardId]
 }
 
@@ -3,8 +3,14 @@ package gateway
 import (
 	"github.com/rxdn/gdl/cache"
 	"github.com/rxdn/gdl/gateway/intents"
+	"github.com/rxdn/gdl/logging"
+	"github.com/rxdn/gdl/metrics"
+	"github.com/rxdn/gdl/objects/channel/message"
 	"github.com/rxdn/gdl/objects/user"
 	"github.com/rxdn/gdl/rest/ratelimit"
+	"github.com/rxdn/gdl/rest/request"
+	"github.com/rxdn/gdl/tracing"
+	"time"
 )
 
 type ShardOptions struct {
@@ -16,7 +22,16 @@ type ShardOptions struct {
 	Hooks                Hooks
 	Debug                bool
 	Intents              []intents.Intent
-	LargeShardingBuckets int // defaults to 1. don't touch unless discord tell you to
+	LargeShardingBuckets int                     // defaults to 1. don't touch unless discord tell you to
+	Metrics              metrics.Metrics         // defaults to metrics.NoopMetrics
+	Logger               logging.Logger          // defaults to logging.StandardLogrusLogger
+	Tracer               tracing.Tracer          // defaults to tracing.NoopTracer
+	GlobalRateLimit      int                     // proactively limit requests per second, e.g. ratelimit.DefaultGlobalLimit. 0 to disable
+	RestProxyUrl         string                  // send REST requests through a proxy, such as cmd/gdl-proxy, e.g. http://localhost:8080
+	RestProxyToken       string                  // the proxy's client token, which is sent instead of the bot token when RestProxyUrl is set
+	RetryPolicy          *request.RetryPolicy    // defaults to request.DefaultRetryPolicy
+	GuildReadyTimeout    time.Duration           // time to wait for the next guild before dispatching SHARD_READY. defaults to 15s
+	AllowedMentions      *message.AllowedMention // applied to outgoing messages that don't set their own, e.g. message.NewAllowedMentions().ParseUsers()
 }
 
 type ShardCount struct {
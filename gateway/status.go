@@ -0,0 +1,79 @@
+package gateway
+
+import (
+	"github.com/rxdn/gdl/utils"
+	"sort"
+	"sync/atomic"
+)
+
+type ShardStatus struct {
+	ShardId            int    `json:"shard_id"`
+	State              string `json:"state"`
+	HasSession         bool   `json:"has_session"`
+	LastHeartbeatAck   int64  `json:"last_heartbeat_ack"` // unix millis
+	HeartbeatLatency   int64  `json:"heartbeat_latency"`  // millis
+	GuildCount         int    `json:"guild_count"`
+	PendingGuilds      int    `json:"pending_guilds"`        // guilds sent in READY that have not been received yet
+	TimeSinceLastEvent int64  `json:"time_since_last_event"` // millis, -1 if no events have been received
+	Ready              bool   `json:"ready"`
+}
+
+type Status struct {
+	Ready  bool          `json:"ready"` // all shards are CONNECTED and have dispatched ShardReady
+	Shards []ShardStatus `json:"shards"`
+}
+
+func (s *Shard) Status() ShardStatus {
+	s.stateLock.RLock()
+	state := s.state
+	s.stateLock.RUnlock()
+
+	s.heartbeatLock.RLock()
+	lastAck := s.lastHeartbeatAcknowledgement
+	latency := s.lastHeartbeatAcknowledgement - s.lastHeartbeat
+	s.heartbeatLock.RUnlock()
+
+	s.sessionLock.RLock()
+	hasSession := s.sessionId != ""
+	s.sessionLock.RUnlock()
+
+	timeSinceLastEvent := int64(-1)
+	if lastEvent := atomic.LoadInt64(&s.lastEvent); lastEvent != 0 {
+		timeSinceLastEvent = utils.GetCurrentTimeMillis() - lastEvent
+	}
+
+	return ShardStatus{
+		ShardId:            s.ShardId,
+		State:              state.String(),
+		HasSession:         hasSession,
+		LastHeartbeatAck:   lastAck,
+		HeartbeatLatency:   latency,
+		GuildCount:         s.GuildCount(),
+		PendingGuilds:      s.PendingGuildCount(),
+		TimeSinceLastEvent: timeSinceLastEvent,
+		Ready:              state == CONNECTED && s.IsReady(),
+	}
+}
+
+// Status returns the status of all shards managed by this ShardManager, ordered by shard ID
+func (sm *ShardManager) Status() Status {
+	status := Status{
+		Ready:  true,
+		Shards: make([]ShardStatus, 0, len(sm.Shards)),
+	}
+
+	for _, shard := range sm.Shards {
+		shardStatus := shard.Status()
+		if !shardStatus.Ready {
+			status.Ready = false
+		}
+
+		status.Shards = append(status.Shards, shardStatus)
+	}
+
+	sort.Slice(status.Shards, func(i, j int) bool {
+		return status.Shards[i].ShardId < status.Shards[j].ShardId
+	})
+
+	return status
+}
@@ -4,6 +4,7 @@ go 1.14
 
 require (
 	github.com/TicketsBot/ttlcache v1.6.1-0.20200405150101-acc18e37b261
+	github.com/alicebob/miniredis/v2 v2.14.1
 	github.com/boltdb/bolt v1.3.1
 	github.com/fatih/structs v1.1.0
 	github.com/go-redis/redis v6.15.7+incompatible
@@ -17,6 +18,7 @@ require (
 	github.com/onsi/gomega v1.9.0 // indirect
 	github.com/pasztorpisti/qs v0.0.0-20171216220353-8d6c33ee906c
 	github.com/pkg/errors v0.9.1 // indirect
+	github.com/prometheus/client_golang v1.7.1
 	github.com/sirupsen/logrus v1.5.0
 	github.com/tatsuworks/czlib v0.0.0-20190916144400-8a51758ea0d9
 	golang.org/x/crypto v0.0.0-20200406173513-056763e48d71 // indirect
@@ -0,0 +1,48 @@
+package logging
+
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+const (
+	KeyShardId = "shard_id"
+	KeyGuildId = "guild_id"
+	KeyRoute   = "route"
+	KeyBucket  = "bucket"
+	KeyStatus  = "status"
+	KeyEvent   = "event"
+	KeyError   = "error"
+)
+
+func Any(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+func ShardId(shardId int) Field {
+	return Field{Key: KeyShardId, Value: shardId}
+}
+
+func GuildId(guildId uint64) Field {
+	return Field{Key: KeyGuildId, Value: guildId}
+}
+
+func Route(route string) Field {
+	return Field{Key: KeyRoute, Value: route}
+}
+
+func Bucket(bucket string) Field {
+	return Field{Key: KeyBucket, Value: bucket}
+}
+
+func Status(status int) Field {
+	return Field{Key: KeyStatus, Value: status}
+}
+
+func Event(eventType string) Field {
+	return Field{Key: KeyEvent, Value: eventType}
+}
+
+func Err(err error) Field {
+	return Field{Key: KeyError, Value: err}
+}
@@ -0,0 +1,12 @@
+package logging
+
+// Logger is a minimal structured logger, which adapters can be written for to route GDL's logs into any logging
+// library. Implementations must be safe for concurrent use.
+type Logger interface {
+	With(fields ...Field) Logger
+
+	Debug(msg string, fields ...Field)
+	Info(msg string, fields ...Field)
+	Warn(msg string, fields ...Field)
+	Error(msg string, fields ...Field)
+}
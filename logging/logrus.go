@@ -0,0 +1,49 @@
+package logging
+
+import "github.com/sirupsen/logrus"
+
+type LogrusLogger struct {
+	entry *logrus.Entry
+}
+
+func NewLogrusLogger(logger logrus.FieldLogger) *LogrusLogger {
+	return &LogrusLogger{
+		entry: logger.WithFields(logrus.Fields{}),
+	}
+}
+
+func (l *LogrusLogger) With(fields ...Field) Logger {
+	return &LogrusLogger{
+		entry: l.entry.WithFields(toLogrusFields(fields)),
+	}
+}
+
+func (l *LogrusLogger) Debug(msg string, fields ...Field) {
+	l.entry.WithFields(toLogrusFields(fields)).Debug(msg)
+}
+
+func (l *LogrusLogger) Info(msg string, fields ...Field) {
+	l.entry.WithFields(toLogrusFields(fields)).Info(msg)
+}
+
+func (l *LogrusLogger) Warn(msg string, fields ...Field) {
+	l.entry.WithFields(toLogrusFields(fields)).Warn(msg)
+}
+
+func (l *LogrusLogger) Error(msg string, fields ...Field) {
+	l.entry.WithFields(toLogrusFields(fields)).Error(msg)
+}
+
+func toLogrusFields(fields []Field) logrus.Fields {
+	converted := make(logrus.Fields, len(fields))
+	for _, field := range fields {
+		converted[field.Key] = field.Value
+	}
+
+	return converted
+}
+
+// StandardLogrusLogger wraps logrus' global logger, and is used by default
+func StandardLogrusLogger() *LogrusLogger {
+	return NewLogrusLogger(logrus.StandardLogger())
+}
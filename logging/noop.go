@@ -0,0 +1,10 @@
+package logging
+
+// NoopLogger discards all messages, useful for silencing GDL in tests
+type NoopLogger struct{}
+
+func (l NoopLogger) With(...Field) Logger { return l }
+func (NoopLogger) Debug(string, ...Field) {}
+func (NoopLogger) Info(string, ...Field)  {}
+func (NoopLogger) Warn(string, ...Field)  {}
+func (NoopLogger) Error(string, ...Field) {}
@@ -0,0 +1,69 @@
+package logging
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// StdLogger writes to a *log.Logger from the standard library, in the format: LEVEL msg key=value key=value
+type StdLogger struct {
+	logger *log.Logger
+	fields []Field
+	debug  bool
+}
+
+// if debug is false, debug messages are discarded
+func NewStdLogger(logger *log.Logger, debug bool) *StdLogger {
+	return &StdLogger{
+		logger: logger,
+		debug:  debug,
+	}
+}
+
+func (l *StdLogger) With(fields ...Field) Logger {
+	combined := make([]Field, 0, len(l.fields)+len(fields))
+	combined = append(combined, l.fields...)
+	combined = append(combined, fields...)
+
+	return &StdLogger{
+		logger: l.logger,
+		fields: combined,
+		debug:  l.debug,
+	}
+}
+
+func (l *StdLogger) Debug(msg string, fields ...Field) {
+	if l.debug {
+		l.log("DEBUG", msg, fields)
+	}
+}
+
+func (l *StdLogger) Info(msg string, fields ...Field) {
+	l.log("INFO", msg, fields)
+}
+
+func (l *StdLogger) Warn(msg string, fields ...Field) {
+	l.log("WARN", msg, fields)
+}
+
+func (l *StdLogger) Error(msg string, fields ...Field) {
+	l.log("ERROR", msg, fields)
+}
+
+func (l *StdLogger) log(level, msg string, fields []Field) {
+	var builder strings.Builder
+	builder.WriteString(level)
+	builder.WriteRune(' ')
+	builder.WriteString(msg)
+
+	for _, field := range l.fields {
+		builder.WriteString(fmt.Sprintf(" %s=%v", field.Key, field.Value))
+	}
+
+	for _, field := range fields {
+		builder.WriteString(fmt.Sprintf(" %s=%v", field.Key, field.Value))
+	}
+
+	l.logger.Println(builder.String())
+}
@@ -0,0 +1,40 @@
+package markdown
+
+import (
+	"strings"
+)
+
+// ZeroWidthSpace is inserted into mentions to stop them from notifying, whilst still displaying the same text
+const ZeroWidthSpace = "\u200b"
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`*`, `\*`,
+	`_`, `\_`,
+	`~`, `\~`,
+	"`", "\\`",
+	`|`, `\|`,
+	`>`, `\>`,
+)
+
+var mentionEscaper = strings.NewReplacer(
+	"@everyone", "@"+ZeroWidthSpace+"everyone",
+	"@here", "@"+ZeroWidthSpace+"here",
+	"<@", "<@"+ZeroWidthSpace, // users and roles
+)
+
+// Escape prevents text from being rendered as markdown, e.g. a username containing underscores
+func Escape(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// EscapeMentions stops @everyone, @here, user and role mentions from notifying. allowed mentions should still be set
+// when sending a message, however, this also stops the mentions from being rendered
+func EscapeMentions(text string) string {
+	return mentionEscaper.Replace(text)
+}
+
+// Sanitize escapes both markdown and mentions, for displaying user provided text as is
+func Sanitize(text string) string {
+	return EscapeMentions(Escape(text))
+}
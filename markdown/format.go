@@ -0,0 +1,100 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// https://discord.com/developers/docs/reference#message-formatting-timestamp-styles
+type TimestampStyle string
+
+const (
+	ShortTime     TimestampStyle = "t" // 16:20
+	LongTime      TimestampStyle = "T" // 16:20:30
+	ShortDate     TimestampStyle = "d" // 20/04/2021
+	LongDate      TimestampStyle = "D" // 20 April 2021
+	ShortDateTime TimestampStyle = "f" // 20 April 2021 16:20, the default
+	LongDateTime  TimestampStyle = "F" // Tuesday, 20 April 2021 16:20
+	RelativeTime  TimestampStyle = "R" // 2 months ago
+)
+
+func Bold(text string) string {
+	return "**" + text + "**"
+}
+
+func Italic(text string) string {
+	return "*" + text + "*"
+}
+
+func Underline(text string) string {
+	return "__" + text + "__"
+}
+
+func Strikethrough(text string) string {
+	return "~~" + text + "~~"
+}
+
+func Spoiler(text string) string {
+	return "||" + text + "||"
+}
+
+// InlineCode uses double backticks if text contains a backtick
+func InlineCode(text string) string {
+	if strings.Contains(text, "`") {
+		return "`` " + text + " ``"
+	}
+
+	return "`" + text + "`"
+}
+
+// CodeBlock wraps content in a code block, with syntax highlighting if language is not blank. code fences inside
+// content are broken up with a zero width space, so that they can't close the block early
+func CodeBlock(language, content string) string {
+	content = strings.ReplaceAll(content, "```", "`"+ZeroWidthSpace+"``")
+	return fmt.Sprintf("```%s\n%s\n```", language, content)
+}
+
+// Quote quotes every line of text
+func Quote(text string) string {
+	return "> " + strings.ReplaceAll(text, "\n", "\n> ")
+}
+
+// BlockQuote quotes text and everything after it in the message
+func BlockQuote(text string) string {
+	return ">>> " + text
+}
+
+// MaskedLink displays text linking to url. masked links are only rendered in embeds and webhook messages
+func MaskedLink(text, url string) string {
+	return fmt.Sprintf("[%s](%s)", text, url)
+}
+
+// Timestamp is displayed in the viewer's timezone and locale. style may be blank, for ShortDateTime
+func Timestamp(t time.Time, style TimestampStyle) string {
+	if style == "" {
+		return fmt.Sprintf("<t:%d>", t.Unix())
+	}
+
+	return fmt.Sprintf("<t:%d:%s>", t.Unix(), style)
+}
+
+func UserMention(userId uint64) string {
+	return fmt.Sprintf("<@%d>", userId)
+}
+
+func RoleMention(roleId uint64) string {
+	return fmt.Sprintf("<@&%d>", roleId)
+}
+
+func ChannelMention(channelId uint64) string {
+	return fmt.Sprintf("<#%d>", channelId)
+}
+
+func CustomEmoji(name string, emojiId uint64, animated bool) string {
+	if animated {
+		return fmt.Sprintf("<a:%s:%d>", name, emojiId)
+	}
+
+	return fmt.Sprintf("<:%s:%d>", name, emojiId)
+}
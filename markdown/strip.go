@@ -0,0 +1,129 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	codeBlockRegex  = regexp.MustCompile("(?s)```(?:[\\w+-]*\\n)?(.*?)\\n?```")
+	inlineCodeRegex = regexp.MustCompile("``\\s?(.+?)\\s?``|`([^`\\n]+)`")
+	escapedRegex    = regexp.MustCompile(`\\([\\*_~` + "`" + `|>\[\]()])`)
+	maskedLinkRegex = regexp.MustCompile(`\[([^\[\]\n]+)\]\(<?(https?://[^\s)>]+)>?\)`)
+	quoteRegex      = regexp.MustCompile(`(?m)^(?:>>> |> )`)
+
+	// longest markers first, so that ** is not mistaken for two *
+	formattingRegexes = []*regexp.Regexp{
+		regexp.MustCompile(`(?s)\*\*(.+?)\*\*`),
+		regexp.MustCompile(`(?s)__(.+?)__`),
+		regexp.MustCompile(`(?s)~~(.+?)~~`),
+		regexp.MustCompile(`(?s)\|\|(.+?)\|\|`),
+		regexp.MustCompile(`\*([^*\s](?:[^*\n]*[^*\s])?)\*`),
+		regexp.MustCompile(`\b_([^_\n]+)_\b`),
+	}
+)
+
+// Strip converts markdown to plain text, removing formatting whilst keeping the text it applies to. code is kept
+// verbatim, and masked links are replaced by their text
+func Strip(text string) string {
+	var builder strings.Builder
+
+	// code blocks and inline code are not formatted, so they are extracted before stripping the rest
+	for _, segment := range splitCode(text) {
+		if segment.code {
+			builder.WriteString(segment.text)
+		} else {
+			builder.WriteString(stripFormatting(segment.text))
+		}
+	}
+
+	return builder.String()
+}
+
+type segment struct {
+	text string
+	code bool
+}
+
+func splitCode(text string) []segment {
+	var segments []segment
+	for _, block := range splitRegex(text, codeBlockRegex) {
+		if block.code {
+			segments = append(segments, block)
+		} else {
+			segments = append(segments, splitRegex(block.text, inlineCodeRegex)...)
+		}
+	}
+
+	return segments
+}
+
+// splitRegex splits text into the matches of regex, which are replaced by their first non empty group, and the text
+// between them
+func splitRegex(text string, regex *regexp.Regexp) []segment {
+	var segments []segment
+
+	last := 0
+	for _, match := range regex.FindAllStringSubmatchIndex(text, -1) {
+		// escaped backticks are not code
+		if match[0] > 0 && text[match[0]-1] == '\\' {
+			continue
+		}
+
+		if match[0] > last {
+			segments = append(segments, segment{text: text[last:match[0]]})
+		}
+
+		var content string
+		for i := 2; i+1 < len(match); i += 2 {
+			if match[i] >= 0 {
+				content = text[match[i]:match[i+1]]
+				break
+			}
+		}
+
+		segments = append(segments, segment{text: content, code: true})
+		last = match[1]
+	}
+
+	if last < len(text) {
+		segments = append(segments, segment{text: text[last:]})
+	}
+
+	return segments
+}
+
+func stripFormatting(text string) string {
+	// escaped characters are replaced by placeholders, so that they are not treated as formatting
+	var escaped []string
+	text = escapedRegex.ReplaceAllStringFunc(text, func(match string) string {
+		escaped = append(escaped, match[1:])
+		return placeholder(len(escaped) - 1)
+	})
+
+	text = maskedLinkRegex.ReplaceAllString(text, "$1")
+	text = quoteRegex.ReplaceAllString(text, "")
+
+	for _, regex := range formattingRegexes {
+		// formatting may be nested, e.g. ***bold italic***
+		for {
+			stripped := regex.ReplaceAllString(text, "$1")
+			if stripped == text {
+				break
+			}
+
+			text = stripped
+		}
+	}
+
+	for i, char := range escaped {
+		text = strings.Replace(text, placeholder(i), char, 1)
+	}
+
+	return text
+}
+
+// placeholders use the private use area, which will not appear in a message
+func placeholder(index int) string {
+	return string(rune(0xE000+index%0x1000)) + string(rune(0xF000+index/0x1000))
+}
@@ -0,0 +1,18 @@
+package metrics
+
+import "time"
+
+// Metrics receives measurements from the gateway, REST and cache layers.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	ShardState(shardId int, state string)
+	HeartbeatLatency(shardId int, latency time.Duration)
+	Reconnect(shardId int)
+	Resume(shardId int)
+	EventReceived(shardId int, eventType string)
+
+	RestRequest(method, route string, status int, latency time.Duration)
+	RateLimitWait(route string, wait time.Duration)
+
+	CacheLookup(resource string, hit bool)
+}
@@ -0,0 +1,15 @@
+package metrics
+
+import "time"
+
+// NoopMetrics discards all measurements, and is used when no Metrics implementation is provided
+type NoopMetrics struct{}
+
+func (NoopMetrics) ShardState(int, string)                         {}
+func (NoopMetrics) HeartbeatLatency(int, time.Duration)            {}
+func (NoopMetrics) Reconnect(int)                                  {}
+func (NoopMetrics) Resume(int)                                     {}
+func (NoopMetrics) EventReceived(int, string)                      {}
+func (NoopMetrics) RestRequest(string, string, int, time.Duration) {}
+func (NoopMetrics) RateLimitWait(string, time.Duration)            {}
+func (NoopMetrics) CacheLookup(string, bool)                       {}
@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// PrometheusMetrics is both a Metrics implementation and a prometheus.Collector, so it can be passed to
+// ShardOptions and registered with a prometheus.Registerer
+type PrometheusMetrics struct {
+	shardState       *prometheus.GaugeVec
+	heartbeatLatency *prometheus.GaugeVec
+	reconnects       *prometheus.CounterVec
+	resumes          *prometheus.CounterVec
+	events           *prometheus.CounterVec
+
+	restRequests   *prometheus.CounterVec
+	restLatency    *prometheus.HistogramVec
+	rateLimitWaits *prometheus.CounterVec
+	rateLimitTime  *prometheus.CounterVec
+
+	cacheLookups *prometheus.CounterVec
+
+	stateLock   sync.Mutex
+	shardStates map[int]string
+}
+
+var _ Metrics = (*PrometheusMetrics)(nil)
+var _ prometheus.Collector = (*PrometheusMetrics)(nil)
+
+func NewPrometheusMetrics(namespace string) *PrometheusMetrics {
+	return &PrometheusMetrics{
+		shardState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "shard_state",
+			Help:      "Current state of each shard, 1 for the active state",
+		}, []string{"shard", "state"}),
+		heartbeatLatency: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "heartbeat_latency_seconds",
+			Help:      "Time between the last heartbeat and its acknowledgement",
+		}, []string{"shard"}),
+		reconnects: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "reconnects_total",
+			Help:      "Number of times each shard has reconnected",
+		}, []string{"shard"}),
+		resumes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "resumes_total",
+			Help:      "Number of times each shard has attempted to resume its session",
+		}, []string{"shard"}),
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "events_total",
+			Help:      "Number of dispatch events received",
+		}, []string{"shard", "event"}),
+		restRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rest",
+			Name:      "requests_total",
+			Help:      "Number of REST requests made, by route and response status",
+		}, []string{"method", "route", "status"}),
+		restLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "rest",
+			Name:      "request_duration_seconds",
+			Help:      "Time taken for Discord to respond to REST requests",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		rateLimitWaits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rest",
+			Name:      "ratelimit_waits_total",
+			Help:      "Number of times a request had to wait for a ratelimit bucket to reset",
+		}, []string{"route"}),
+		rateLimitTime: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rest",
+			Name:      "ratelimit_wait_seconds_total",
+			Help:      "Total time spent waiting on ratelimit buckets",
+		}, []string{"route"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "lookups_total",
+			Help:      "Number of cache lookups made by REST wrappers, by result",
+		}, []string{"resource", "result"}),
+		shardStates: make(map[int]string),
+	}
+}
+
+func (m *PrometheusMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.shardState,
+		m.heartbeatLatency,
+		m.reconnects,
+		m.resumes,
+		m.events,
+		m.restRequests,
+		m.restLatency,
+		m.rateLimitWaits,
+		m.rateLimitTime,
+		m.cacheLookups,
+	}
+}
+
+func (m *PrometheusMetrics) Describe(ch chan<- *prometheus.Desc) {
+	for _, collector := range m.collectors() {
+		collector.Describe(ch)
+	}
+}
+
+func (m *PrometheusMetrics) Collect(ch chan<- prometheus.Metric) {
+	for _, collector := range m.collectors() {
+		collector.Collect(ch)
+	}
+}
+
+func (m *PrometheusMetrics) ShardState(shardId int, state string) {
+	shard := strconv.Itoa(shardId)
+
+	m.stateLock.Lock()
+	defer m.stateLock.Unlock()
+
+	// zero the previous state, so only 1 state is active per shard
+	if previous, ok := m.shardStates[shardId]; ok && previous != state {
+		m.shardState.WithLabelValues(shard, previous).Set(0)
+	}
+
+	m.shardStates[shardId] = state
+	m.shardState.WithLabelValues(shard, state).Set(1)
+}
+
+func (m *PrometheusMetrics) HeartbeatLatency(shardId int, latency time.Duration) {
+	m.heartbeatLatency.WithLabelValues(strconv.Itoa(shardId)).Set(latency.Seconds())
+}
+
+func (m *PrometheusMetrics) Reconnect(shardId int) {
+	m.reconnects.WithLabelValues(strconv.Itoa(shardId)).Inc()
+}
+
+func (m *PrometheusMetrics) Resume(shardId int) {
+	m.resumes.WithLabelValues(strconv.Itoa(shardId)).Inc()
+}
+
+func (m *PrometheusMetrics) EventReceived(shardId int, eventType string) {
+	m.events.WithLabelValues(strconv.Itoa(shardId), eventType).Inc()
+}
+
+func (m *PrometheusMetrics) RestRequest(method, route string, status int, latency time.Duration) {
+	m.restRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	m.restLatency.WithLabelValues(method, route).Observe(latency.Seconds())
+}
+
+func (m *PrometheusMetrics) RateLimitWait(route string, wait time.Duration) {
+	m.rateLimitWaits.WithLabelValues(route).Inc()
+	m.rateLimitTime.WithLabelValues(route).Add(wait.Seconds())
+}
+
+func (m *PrometheusMetrics) CacheLookup(resource string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	m.cacheLookups.WithLabelValues(resource, result).Inc()
+}
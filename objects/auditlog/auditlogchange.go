@@ -0,0 +1,20 @@
+package auditlog
+
+import "encoding/json"
+
+// the type of the values depends on the key, e.g. a string for "name", or a []guild.Role for "$add"
+type AuditLogChange struct {
+	NewValue json.RawMessage `json:"new_value,omitempty"`
+	OldValue json.RawMessage `json:"old_value,omitempty"`
+	Key      string          `json:"key"`
+}
+
+// DecodeNewValue unmarshals the new value into v
+func (c *AuditLogChange) DecodeNewValue(v interface{}) error {
+	return json.Unmarshal(c.NewValue, v)
+}
+
+// DecodeOldValue unmarshals the old value into v
+func (c *AuditLogChange) DecodeOldValue(v interface{}) error {
+	return json.Unmarshal(c.OldValue, v)
+}
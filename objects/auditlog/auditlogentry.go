@@ -0,0 +1,13 @@
+package auditlog
+
+import "github.com/rxdn/gdl/utils"
+
+type AuditLogEntry struct {
+	TargetId   utils.Snowflake         `json:"target_id"` // 0 if there is no target
+	Changes    []AuditLogChange        `json:"changes,omitempty"`
+	UserId     utils.Snowflake         `json:"user_id"`
+	Id         utils.Snowflake         `json:"id"`
+	ActionType AuditLogEvent           `json:"action_type"`
+	Options    *OptionalAuditEntryInfo `json:"options,omitempty"`
+	Reason     string                  `json:"reason,omitempty"`
+}
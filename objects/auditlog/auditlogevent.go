@@ -0,0 +1,41 @@
+package auditlog
+
+type AuditLogEvent int
+
+const (
+	GUILD_UPDATE             AuditLogEvent = 1
+	CHANNEL_CREATE           AuditLogEvent = 10
+	CHANNEL_UPDATE           AuditLogEvent = 11
+	CHANNEL_DELETE           AuditLogEvent = 12
+	CHANNEL_OVERWRITE_CREATE AuditLogEvent = 13
+	CHANNEL_OVERWRITE_UPDATE AuditLogEvent = 14
+	CHANNEL_OVERWRITE_DELETE AuditLogEvent = 15
+	MEMBER_KICK              AuditLogEvent = 20
+	MEMBER_PRUNE             AuditLogEvent = 21
+	MEMBER_BAN_ADD           AuditLogEvent = 22
+	MEMBER_BAN_REMOVE        AuditLogEvent = 23
+	MEMBER_UPDATE            AuditLogEvent = 24
+	MEMBER_ROLE_UPDATE       AuditLogEvent = 25
+	MEMBER_MOVE              AuditLogEvent = 26
+	MEMBER_DISCONNECT        AuditLogEvent = 27
+	BOT_ADD                  AuditLogEvent = 28
+	ROLE_CREATE              AuditLogEvent = 30
+	ROLE_UPDATE              AuditLogEvent = 31
+	ROLE_DELETE              AuditLogEvent = 32
+	INVITE_CREATE            AuditLogEvent = 40
+	INVITE_UPDATE            AuditLogEvent = 41
+	INVITE_DELETE            AuditLogEvent = 42
+	WEBHOOK_CREATE           AuditLogEvent = 50
+	WEBHOOK_UPDATE           AuditLogEvent = 51
+	WEBHOOK_DELETE           AuditLogEvent = 52
+	EMOJI_CREATE             AuditLogEvent = 60
+	EMOJI_UPDATE             AuditLogEvent = 61
+	EMOJI_DELETE             AuditLogEvent = 62
+	MESSAGE_DELETE           AuditLogEvent = 72
+	MESSAGE_BULK_DELETE      AuditLogEvent = 73
+	MESSAGE_PIN              AuditLogEvent = 74
+	MESSAGE_UNPIN            AuditLogEvent = 75
+	INTEGRATION_CREATE       AuditLogEvent = 80
+	INTEGRATION_UPDATE       AuditLogEvent = 81
+	INTEGRATION_DELETE       AuditLogEvent = 82
+)
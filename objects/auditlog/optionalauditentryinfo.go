@@ -0,0 +1,15 @@
+package auditlog
+
+import "github.com/rxdn/gdl/utils"
+
+// which fields are present depends on the ActionType of the entry
+type OptionalAuditEntryInfo struct {
+	DeleteMemberDays int             `json:"delete_member_days,string,omitempty"` // MEMBER_PRUNE
+	MembersRemoved   int             `json:"members_removed,string,omitempty"`    // MEMBER_PRUNE
+	ChannelId        utils.Snowflake `json:"channel_id,omitempty"`                // MEMBER_MOVE, MESSAGE_PIN, MESSAGE_UNPIN, MESSAGE_DELETE
+	MessageId        utils.Snowflake `json:"message_id,omitempty"`                // MESSAGE_PIN, MESSAGE_UNPIN
+	Count            int             `json:"count,string,omitempty"`              // MESSAGE_DELETE, MESSAGE_BULK_DELETE, MEMBER_DISCONNECT, MEMBER_MOVE
+	Id               utils.Snowflake `json:"id,omitempty"`                        // CHANNEL_OVERWRITE_*
+	Type             string          `json:"type,omitempty"`                      // CHANNEL_OVERWRITE_*, "member" or "role"
+	RoleName         string          `json:"role_name,omitempty"`                 // CHANNEL_OVERWRITE_*, if type is "role"
+}
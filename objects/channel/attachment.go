@@ -1,11 +1,13 @@
 package channel
 
+import "github.com/rxdn/gdl/utils"
+
 type Attachment struct {
-	Id       uint64 `json:",string"`
-	Filename string `json:"filename"`
-	Size     int    `json:"size"`
-	Url      string `json:"url"`
-	ProxyUrl string `json:"proxy_url"`
-	Height   int    `json:"height"`
-	Width    int    `json:"width"`
+	Id       utils.Snowflake `json:"id"`
+	Filename string          `json:"filename"`
+	Size     int             `json:"size"`
+	Url      string          `json:"url"`
+	ProxyUrl string          `json:"proxy_url"`
+	Height   int             `json:"height"`
+	Width    int             `json:"width"`
 }
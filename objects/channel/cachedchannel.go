@@ -1,6 +1,9 @@
 package channel
 
-import "time"
+import (
+	"github.com/rxdn/gdl/utils"
+	"time"
+)
 
 type CachedChannel struct {
 	Type                 ChannelType           `db:"type"`
@@ -22,23 +25,23 @@ type CachedChannel struct {
 
 func (c *CachedChannel) ToChannel(channelId, guildId uint64) Channel {
 	return Channel{
-		Id:                   channelId,
+		Id:                   utils.Snowflake(channelId),
 		Type:                 c.Type,
-		GuildId:              guildId,
+		GuildId:              utils.Snowflake(guildId),
 		Position:             c.Position,
 		PermissionOverwrites: c.PermissionOverwrites,
 		Name:                 c.Name,
 		Topic:                c.Topic,
 		Nsfw:                 c.Nsfw,
-		LastMessageId:        c.LastMessageId,
+		LastMessageId:        utils.Snowflake(c.LastMessageId),
 		Bitrate:              c.Bitrate,
 		UserLimit:            c.UserLimit,
 		RateLimitPerUser:     c.RateLimitPerUser,
 		Recipients:           nil,
 		Icon:                 c.Icon,
-		OwnerId:              c.OwnerId,
-		ApplicationId:        c.ApplicationId,
-		ParentId:             c.ParentId,
+		OwnerId:              utils.Snowflake(c.OwnerId),
+		ApplicationId:        utils.Snowflake(c.ApplicationId),
+		ParentId:             utils.Snowflake(c.ParentId),
 		LastPinTimestamp:     c.LastPinTimestamp,
 	}
 }
@@ -3,27 +3,28 @@ package channel
 import (
 	"fmt"
 	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/utils"
 	"time"
 )
 
 type Channel struct {
-	Id                   uint64                `json:"id,string"`
+	Id                   utils.Snowflake       `json:"id"`
 	Type                 ChannelType           `json:"type"`
-	GuildId              uint64                `json:"guild_id,string"`
+	GuildId              utils.Snowflake       `json:"guild_id"`
 	Position             int                   `json:"position"`
 	PermissionOverwrites []PermissionOverwrite `json:"permission_overwrites"`
 	Name                 string                `json:"name"`
 	Topic                string                `json:"topic"`
 	Nsfw                 bool                  `json:"nsfw"`
-	LastMessageId        uint64                `json:"last_message_id,string"`
+	LastMessageId        utils.Snowflake       `json:"last_message_id"`
 	Bitrate              int                   `json:"bitrate"`
 	UserLimit            int                   `json:"user_limit"`
 	RateLimitPerUser     int                   `json:"rate_limit_per_user"`
 	Recipients           []user.User           `json:"recipients"`
 	Icon                 string                `json:"icon"`
-	OwnerId              uint64                `json:"owner_id,string"` // Owner of a group DM
-	ApplicationId        uint64                `json:"application_id,string"`
-	ParentId             uint64                `json:"parent_id,string,omitempty"`
+	OwnerId              utils.Snowflake       `json:"owner_id"` // Owner of a group DM
+	ApplicationId        utils.Snowflake       `json:"application_id"`
+	ParentId             utils.Snowflake       `json:"parent_id,omitempty"`
 	LastPinTimestamp     time.Time             `json:"last_pin_timestamp"`
 }
 
@@ -39,14 +40,14 @@ func (c *Channel) ToCachedChannel() CachedChannel {
 		Name:                 c.Name,
 		Topic:                c.Topic,
 		Nsfw:                 c.Nsfw,
-		LastMessageId:        c.LastMessageId,
+		LastMessageId:        uint64(c.LastMessageId),
 		Bitrate:              c.Bitrate,
 		UserLimit:            c.UserLimit,
 		RateLimitPerUser:     c.RateLimitPerUser,
 		Icon:                 c.Icon,
-		OwnerId:              c.OwnerId,
-		ApplicationId:        c.ApplicationId,
-		ParentId:             c.ParentId,
+		OwnerId:              uint64(c.OwnerId),
+		ApplicationId:        uint64(c.ApplicationId),
+		ParentId:             uint64(c.ParentId),
 		LastPinTimestamp:     c.LastPinTimestamp,
 	}
 }
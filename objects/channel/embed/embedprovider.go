@@ -2,5 +2,5 @@ package embed
 
 type EmbedProvider struct {
 	Name string `json:"name"`
-	Url  string `json:"name"`
+	Url  string `json:"url"`
 }
@@ -2,13 +2,102 @@ package message
 
 import "github.com/rxdn/gdl/utils"
 
+// AllowedMention controls which mentions in a message will notify. https://discord.com/developers/docs/resources/channel#allowed-mentions-object
+// users and roles listed explicitly must not also be parsed, so the builder methods remove one when adding the other.
+// builder methods return a copy, so that shared values such as MentionEveryone are never modified.
 type AllowedMention struct {
-	Parse []AllowedMentionType      `json:"parse"`
-	Roles []utils.Uint64StringSlice `json:"roles"`
-	Users []utils.Uint64StringSlice `json:"users"`
+	Parse   []AllowedMentionType    `json:"parse"` // an empty slice prevents all mentions
+	RoleIds utils.Uint64StringSlice `json:"roles,omitempty"`
+	UserIds utils.Uint64StringSlice `json:"users,omitempty"`
 }
 
 // Helper
-var MentionEveryone = AllowedMention{
-	Parse: []AllowedMentionType{EVERYONE},
+var MentionEveryone = NewAllowedMentions().ParseEveryone()
+
+// NewAllowedMentions allows no mentions, until more are added using the builder methods
+func NewAllowedMentions() *AllowedMention {
+	return &AllowedMention{
+		Parse: []AllowedMentionType{},
+	}
+}
+
+// None prevents all mentions from notifying
+func (m AllowedMention) None() *AllowedMention {
+	return NewAllowedMentions()
+}
+
+// ParseUsers allows all user mentions in the content
+func (m AllowedMention) ParseUsers() *AllowedMention {
+	copied := m.copy()
+	copied.UserIds = nil
+	copied.parse(USERS)
+	return copied
+}
+
+// ParseRoles allows all role mentions in the content
+func (m AllowedMention) ParseRoles() *AllowedMention {
+	copied := m.copy()
+	copied.RoleIds = nil
+	copied.parse(ROLES)
+	return copied
+}
+
+// ParseEveryone allows @everyone and @here
+func (m AllowedMention) ParseEveryone() *AllowedMention {
+	copied := m.copy()
+	copied.parse(EVERYONE)
+	return copied
+}
+
+// Users allows mentions of only the given users
+func (m AllowedMention) Users(userIds ...uint64) *AllowedMention {
+	copied := m.copy()
+	copied.removeParse(USERS)
+	copied.UserIds = append(copied.UserIds, userIds...)
+	return copied
+}
+
+// Roles allows mentions of only the given roles
+func (m AllowedMention) Roles(roleIds ...uint64) *AllowedMention {
+	copied := m.copy()
+	copied.removeParse(ROLES)
+	copied.RoleIds = append(copied.RoleIds, roleIds...)
+	return copied
+}
+
+func (m AllowedMention) copy() *AllowedMention {
+	copied := &AllowedMention{
+		Parse: append([]AllowedMentionType{}, m.Parse...),
+	}
+
+	if m.RoleIds != nil {
+		copied.RoleIds = append(utils.Uint64StringSlice{}, m.RoleIds...)
+	}
+
+	if m.UserIds != nil {
+		copied.UserIds = append(utils.Uint64StringSlice{}, m.UserIds...)
+	}
+
+	return copied
+}
+
+func (m *AllowedMention) parse(mentionType AllowedMentionType) {
+	for _, existing := range m.Parse {
+		if existing == mentionType {
+			return
+		}
+	}
+
+	m.Parse = append(m.Parse, mentionType)
+}
+
+func (m *AllowedMention) removeParse(mentionType AllowedMentionType) {
+	filtered := m.Parse[:0]
+	for _, existing := range m.Parse {
+		if existing != mentionType {
+			filtered = append(filtered, existing)
+		}
+	}
+
+	m.Parse = filtered
 }
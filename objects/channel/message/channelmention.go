@@ -1,10 +1,13 @@
 package message
 
-import "github.com/rxdn/gdl/objects/channel"
+import (
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/utils"
+)
 
 type ChannelMention struct {
-	Id      uint64              `json:"id,string"`
-	GuildId uint64              `json:"guild_id,string"`
+	Id      utils.Snowflake     `json:"id"`
+	GuildId utils.Snowflake     `json:"guild_id"`
 	Type    channel.ChannelType `json:"type"`
 	Name    string              `json:"name"` // channel name
 }
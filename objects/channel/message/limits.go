@@ -0,0 +1,6 @@
+package message
+
+const (
+	MaxContentLength = 2000 // characters
+	MaxEmbeds        = 10   // per webhook message
+)
@@ -1,20 +1,19 @@
 package message
 
 import (
+	"github.com/rxdn/gdl/content"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/channel/embed"
 	"github.com/rxdn/gdl/objects/member"
 	"github.com/rxdn/gdl/objects/user"
 	"github.com/rxdn/gdl/utils"
-	"regexp"
-	"strconv"
 	"time"
 )
 
 type Message struct {
-	Id                       uint64                  `json:"id,string"`
-	ChannelId                uint64                  `json:"channel_id,string"`
-	GuildId                  uint64                  `json:"guild_id,string"`
+	Id                       utils.Snowflake         `json:"id"`
+	ChannelId                utils.Snowflake         `json:"channel_id"`
+	GuildId                  utils.Snowflake         `json:"guild_id"`
 	Author                   user.User               `json:"author"`
 	Member                   member.Member           `json:"member"`
 	Content                  string                  `json:"content"`
@@ -30,7 +29,7 @@ type Message struct {
 	Reactions                []Reaction              `json:"reactions"`
 	Nonce                    interface{}             `json:"nonce"`
 	Pinned                   bool                    `json:"pinned"`
-	WebhookId                uint64                  `json:"webhook_id,string"` // if the message is generated by a webhook, this is the webhook's id
+	WebhookId                utils.Snowflake         `json:"webhook_id"` // if the message is generated by a webhook, this is the webhook's id
 	Type                     MessageType             `json:"message_type"`
 	Activity                 MessageActivity         `json:"activity"`
 	Application              MessageApplication      `json:"application"`
@@ -38,21 +37,21 @@ type Message struct {
 	Flags                    int                     `json:"flags"`
 }
 
-var channelMentionRegex = regexp.MustCompile(`<#(\d+)>`)
-
 func (m *Message) ChannelMentions() []uint64 {
 	mentions := make([]uint64, 0)
-
-	for _, match := range channelMentionRegex.FindAllStringSubmatch(m.Content, -1) {
-		if len(match) < 2 {
-			continue
-		}
-
-		raw := match[1]
-		if id, err := strconv.ParseUint(raw, 10, 64); err == nil {
-			mentions = append(mentions, id)
-		}
+	for _, token := range content.Filter(m.Tokens(), content.ChannelMention) {
+		mentions = append(mentions, token.Id)
 	}
 
 	return mentions
 }
+
+// Tokens returns the mentions, custom emojis, timestamps and URLs in the message's content
+func (m *Message) Tokens() []content.Token {
+	return content.Parse(m.Content)
+}
+
+// CleanContent returns the content with mentions replaced by names, see content.Clean
+func (m *Message) CleanContent(resolver content.Resolver) string {
+	return content.Clean(m.Content, uint64(m.GuildId), resolver)
+}
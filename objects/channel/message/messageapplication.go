@@ -1,7 +1,9 @@
 package message
 
+import "github.com/rxdn/gdl/utils"
+
 type MessageApplication struct {
-	Id          uint64 `json:",string"`
+	Id          utils.Snowflake `json:"id"`
 	CoverImage  string
 	Description string
 	Icon        string
@@ -1,7 +1,9 @@
 package message
 
+import "github.com/rxdn/gdl/utils"
+
 type MessageReference struct {
-	MessageId uint64 `json:"message_id,string"`
-	ChannelId uint64 `json:"channel_id,string"`
-	GuildId   uint64 `json:"guild_id,string"`
+	MessageId utils.Snowflake `json:"message_id"`
+	ChannelId utils.Snowflake `json:"channel_id"`
+	GuildId   utils.Snowflake `json:"guild_id"`
 }
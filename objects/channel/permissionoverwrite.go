@@ -1,7 +1,9 @@
 package channel
 
+import "github.com/rxdn/gdl/utils"
+
 type PermissionOverwrite struct {
-	Id    uint64                  `json:"id,string,omitempty"`
+	Id    utils.Snowflake         `json:"id,omitempty"`
 	Type  PermissionOverwriteType `json:"type"`
 	Allow int                     `json:"allow"`
 	Deny  int                     `json:"deny"`
@@ -1,6 +1,7 @@
 package guild
 
 import (
+	"github.com/rxdn/gdl/utils"
 	"time"
 )
 
@@ -39,27 +40,27 @@ type CachedGuild struct {
 
 func (g *CachedGuild) ToGuild(guildId uint64) Guild {
 	return Guild{
-		Id:                          guildId,
+		Id:                          utils.Snowflake(guildId),
 		Name:                        g.Name,
 		Icon:                        g.Icon,
 		Splash:                      g.Splash,
 		Owner:                       g.Owner,
-		OwnerId:                     g.OwnerId,
+		OwnerId:                     utils.Snowflake(g.OwnerId),
 		Permissions:                 g.Permissions,
 		Region:                      g.Region,
-		AfkChannelId:                g.AfkChannelId,
+		AfkChannelId:                utils.Snowflake(g.AfkChannelId),
 		AfkTimeout:                  g.AfkTimeout,
 		EmbedEnabled:                g.EmbedEnabled,
-		EmbedChannelId:              g.EmbedChannelId,
+		EmbedChannelId:              utils.Snowflake(g.EmbedChannelId),
 		VerificationLevel:           g.VerificationLevel,
 		DefaultMessageNotifications: g.DefaultMessageNotifications,
 		ExplicitContentFilter:       g.ExplicitContentFilter,
 		Features:                    g.Features,
 		MfaLevel:                    g.MfaLevel,
-		ApplicationId:               g.ApplicationId,
+		ApplicationId:               utils.Snowflake(g.ApplicationId),
 		WidgetEnabled:               g.WidgetEnabled,
-		WidgetChannelId:             g.WidgetChannelId,
-		SystemChannelId:             g.SystemChannelId,
+		WidgetChannelId:             utils.Snowflake(g.WidgetChannelId),
+		SystemChannelId:             utils.Snowflake(g.SystemChannelId),
 		JoinedAt:                    g.JoinedAt,
 		Large:                       g.Large,
 		Unavailable:                 g.Unavailable,
@@ -1,5 +1,7 @@
 package guild
 
+import "github.com/rxdn/gdl/utils"
+
 type CachedRole struct {
 	Name        string `db:"name"`
 	Color       int    `db:"color"`
@@ -12,7 +14,7 @@ type CachedRole struct {
 
 func (r *CachedRole) ToRole(roleId uint64) Role {
 	return Role{
-		Id:          roleId,
+		Id:          utils.Snowflake(roleId),
 		Name:        r.Name,
 		Color:       r.Color,
 		Hoist:       r.Hoist,
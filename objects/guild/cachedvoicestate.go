@@ -2,6 +2,7 @@ package guild
 
 import (
 	"github.com/rxdn/gdl/objects/member"
+	"github.com/rxdn/gdl/utils"
 )
 
 type CachedVoiceState struct {
@@ -16,8 +17,8 @@ type CachedVoiceState struct {
 
 func (s *CachedVoiceState) ToVoiceState(guildId uint64, m member.Member) VoiceState {
 	return VoiceState{
-		GuildId:   guildId,
-		ChannelId: s.ChannelId,
+		GuildId:   utils.Snowflake(guildId),
+		ChannelId: utils.Snowflake(s.ChannelId),
 		UserId:    m.User.Id,
 		Member:    m,
 		SessionId: s.SessionId,
@@ -1,6 +1,9 @@
 package emoji
 
-import "github.com/rxdn/gdl/objects/user"
+import (
+	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/utils"
+)
 
 type CachedEmoji struct {
 	Name          string   `db:"name"`
@@ -13,7 +16,7 @@ type CachedEmoji struct {
 
 func (e *CachedEmoji) ToEmoji(emojiId uint64, user user.User) Emoji {
 	return Emoji{
-		Id:            emojiId,
+		Id:            utils.Snowflake(emojiId),
 		Name:          e.Name,
 		Roles:         e.Roles,
 		User:          user,
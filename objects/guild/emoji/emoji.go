@@ -1,13 +1,14 @@
 package emoji
 
 import (
+	"github.com/rxdn/gdl/cdn"
 	"github.com/rxdn/gdl/objects/user"
 	"github.com/rxdn/gdl/utils"
 )
 
 // https://discord.com/developers/docs/resources/emoji#emoji-object
 type Emoji struct {
-	Id            uint64                  `json:"id,string"`
+	Id            utils.Snowflake         `json:"id"`
 	Name          string                  `json:"name"` // if this is not a custom emote, Name will be the unicode emoji, and Id will be 0
 	Roles         utils.Uint64StringSlice `json:"roles,string"`
 	User          user.User               `json:"user"`
@@ -16,11 +17,16 @@ type Emoji struct {
 	Animated      bool                    `json:"animated"`
 }
 
+// Url returns cdn.ErrNoAsset for unicode emojis
+func (e *Emoji) Url(format cdn.Format, size int) (string, error) {
+	return cdn.CustomEmoji(uint64(e.Id), e.Animated, format, size)
+}
+
 func (e *Emoji) ToCachedEmoji() CachedEmoji {
 	return CachedEmoji{
 		Name:          e.Name,
 		Roles:         e.Roles,
-		User:          e.User.Id,
+		User:          uint64(e.User.Id),
 		RequireColons: e.RequireColons,
 		Managed:       e.Managed,
 		Animated:      e.Animated,
@@ -1,26 +1,28 @@
 package guild
 
 import (
+	"github.com/rxdn/gdl/cdn"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/guild/emoji"
 	"github.com/rxdn/gdl/objects/member"
 	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/utils"
 	"time"
 )
 
 type Guild struct {
-	Id                          uint64            `json:"id,string"`
+	Id                          utils.Snowflake   `json:"id"`
 	Name                        string            `json:"name"`
 	Icon                        string            `json:"icon"`
 	Splash                      string            `json:"splash"`
 	Owner                       bool              `json:"owner"`
-	OwnerId                     uint64            `json:"owner_id,string"`
+	OwnerId                     utils.Snowflake   `json:"owner_id"`
 	Permissions                 int               `json:"permissions"`
 	Region                      string            `json:"region"`
-	AfkChannelId                uint64            `json:"afk_channel_id,string"`
+	AfkChannelId                utils.Snowflake   `json:"afk_channel_id"`
 	AfkTimeout                  int               `json:"afk_timeout"`
 	EmbedEnabled                bool              `json:"embed_enabled"`
-	EmbedChannelId              uint64            `json:"embed_channel_id,string"`
+	EmbedChannelId              utils.Snowflake   `json:"embed_channel_id"`
 	VerificationLevel           int               `json:"verification_level"`
 	DefaultMessageNotifications int               `json:"default_message_notifications"`
 	ExplicitContentFilter       int               `json:"explicit_content_filter"`
@@ -28,10 +30,10 @@ type Guild struct {
 	Emojis                      []emoji.Emoji     `json:"emojis"`
 	Features                    []GuildFeature    `json:"features"`
 	MfaLevel                    int               `json:"mfa_level"`
-	ApplicationId               uint64            `json:"application_id,string"`
+	ApplicationId               utils.Snowflake   `json:"application_id"`
 	WidgetEnabled               bool              `json:"widget_enabled"`
-	WidgetChannelId             uint64            `json:"widget_channel_id,string"`
-	SystemChannelId             uint64            `json:"system_channel_id,string"`
+	WidgetChannelId             utils.Snowflake   `json:"widget_channel_id"`
+	SystemChannelId             utils.Snowflake   `json:"system_channel_id"`
 	JoinedAt                    time.Time         `json:"joined_at"`
 	Large                       bool              `json:"large"`
 	Unavailable                 *bool             `json:"unavailable"`
@@ -49,29 +51,42 @@ type Guild struct {
 	ApproximatePresenceCount    int               `json:"approximate_presence_count"` // Returned on GET /guild/:id
 }
 
+// IconUrl returns cdn.ErrNoAsset if the guild has no icon
+func (g *Guild) IconUrl(format cdn.Format, size int) (string, error) {
+	return cdn.GuildIcon(uint64(g.Id), g.Icon, format, size)
+}
+
+func (g *Guild) SplashUrl(format cdn.Format, size int) (string, error) {
+	return cdn.GuildSplash(uint64(g.Id), g.Splash, format, size)
+}
+
+func (g *Guild) BannerUrl(format cdn.Format, size int) (string, error) {
+	return cdn.GuildBanner(uint64(g.Id), g.Banner, format, size)
+}
+
 func (g *Guild) ToCachedGuild() CachedGuild {
 	return CachedGuild{
-		Id:                          g.Id,
+		Id:                          uint64(g.Id),
 		Name:                        g.Name,
 		Icon:                        g.Icon,
 		Splash:                      g.Splash,
 		Owner:                       g.Owner,
-		OwnerId:                     g.OwnerId,
+		OwnerId:                     uint64(g.OwnerId),
 		Permissions:                 g.Permissions,
 		Region:                      g.Region,
-		AfkChannelId:                g.AfkChannelId,
+		AfkChannelId:                uint64(g.AfkChannelId),
 		AfkTimeout:                  g.AfkTimeout,
 		EmbedEnabled:                g.EmbedEnabled,
-		EmbedChannelId:              g.EmbedChannelId,
+		EmbedChannelId:              uint64(g.EmbedChannelId),
 		VerificationLevel:           g.VerificationLevel,
 		DefaultMessageNotifications: g.DefaultMessageNotifications,
 		ExplicitContentFilter:       g.ExplicitContentFilter,
 		Features:                    g.Features,
 		MfaLevel:                    g.MfaLevel,
-		ApplicationId:               g.ApplicationId,
+		ApplicationId:               uint64(g.ApplicationId),
 		WidgetEnabled:               g.WidgetEnabled,
-		WidgetChannelId:             g.WidgetChannelId,
-		SystemChannelId:             g.SystemChannelId,
+		WidgetChannelId:             uint64(g.WidgetChannelId),
+		SystemChannelId:             uint64(g.SystemChannelId),
 		JoinedAt:                    g.JoinedAt,
 		Large:                       g.Large,
 		Unavailable:                 g.Unavailable,
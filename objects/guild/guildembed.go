@@ -1,6 +1,8 @@
 package guild
 
+import "github.com/rxdn/gdl/utils"
+
 type GuildEmbed struct {
-	Enabled   bool   `json:"enabled"`
-	ChannelId uint64 `json:"channel_id,string"`
+	Enabled   bool            `json:"enabled"`
+	ChannelId utils.Snowflake `json:"channel_id"`
 }
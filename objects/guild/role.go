@@ -2,17 +2,18 @@ package guild
 
 import (
 	"fmt"
+	"github.com/rxdn/gdl/utils"
 )
 
 type Role struct {
-	Id          uint64 `json:"id,string"`
-	Name        string `json:"name"`
-	Color       int    `json:"color"`
-	Hoist       bool   `json:"hoist"`
-	Position    int    `json:"position"`
-	Permissions int    `json:"permissions"`
-	Managed     bool   `json:"managed"`
-	Mentionable bool   `json:"mentionable"`
+	Id          utils.Snowflake `json:"id"`
+	Name        string          `json:"name"`
+	Color       int             `json:"color"`
+	Hoist       bool            `json:"hoist"`
+	Position    int             `json:"position"`
+	Permissions int             `json:"permissions"`
+	Managed     bool            `json:"managed"`
+	Mentionable bool            `json:"mentionable"`
 }
 
 func (r *Role) Mention() string {
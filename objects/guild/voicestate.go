@@ -1,23 +1,26 @@
 package guild
 
-import "github.com/rxdn/gdl/objects/member"
+import (
+	"github.com/rxdn/gdl/objects/member"
+	"github.com/rxdn/gdl/utils"
+)
 
 type VoiceState struct {
-	GuildId   uint64        `json:"guild_id,string"`
-	ChannelId uint64        `json:"channel_id,string"`
-	UserId    uint64        `json:"user_id,string"`
-	Member    member.Member `json:"member"`
-	SessionId string        `json:"session_id"`
-	Deaf      bool          `json:"deaf"`
-	Mute      bool          `json:"mute"`
-	SelfDeaf  bool          `json:"self_deaf"`
-	SelfMute  bool          `json:"self_mute"`
-	Suppress  bool          `json:"suppress"`
+	GuildId   utils.Snowflake `json:"guild_id"`
+	ChannelId utils.Snowflake `json:"channel_id"`
+	UserId    utils.Snowflake `json:"user_id"`
+	Member    member.Member   `json:"member"`
+	SessionId string          `json:"session_id"`
+	Deaf      bool            `json:"deaf"`
+	Mute      bool            `json:"mute"`
+	SelfDeaf  bool            `json:"self_deaf"`
+	SelfMute  bool            `json:"self_mute"`
+	Suppress  bool            `json:"suppress"`
 }
 
 func (s *VoiceState) ToCachedVoiceState() CachedVoiceState {
 	return CachedVoiceState{
-		ChannelId: s.ChannelId,
+		ChannelId: uint64(s.ChannelId),
 		SessionId: s.SessionId,
 		Deaf:      s.Deaf,
 		Mute:      s.Mute,
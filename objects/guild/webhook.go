@@ -1,14 +1,17 @@
 package guild
 
-import "github.com/rxdn/gdl/objects/user"
+import (
+	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/utils"
+)
 
 type Webhook struct {
-	Id        uint64      `json:"id,string"`
-	Type      WebhookType `json:"type"`
-	GuildId   uint64      `json:"guild_id,string,omitempty"`
-	ChannelId uint64      `json:"channel_id,string"`
-	User      user.User   `json:"user"`
-	Name      string      `json:"name,omitempty"`
-	Avatar    string      `json:"avatar,omitempty"`
-	Token     string      `json:"token,omitempty"`
+	Id        utils.Snowflake `json:"id"`
+	Type      WebhookType     `json:"type"`
+	GuildId   utils.Snowflake `json:"guild_id,omitempty"`
+	ChannelId utils.Snowflake `json:"channel_id"`
+	User      user.User       `json:"user"`
+	Name      string          `json:"name,omitempty"`
+	Avatar    string          `json:"avatar,omitempty"`
+	Token     string          `json:"token,omitempty"`
 }
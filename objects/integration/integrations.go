@@ -3,16 +3,17 @@ package integration
 import (
 	"github.com/rxdn/gdl/objects/guild"
 	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/utils"
 	"time"
 )
 
 type Integration struct {
-	Id                uint64                     `json:"id,string"`
+	Id                utils.Snowflake            `json:"id"`
 	Name              string                     `json:"name"`
 	Type              string                     `json:"type"` // twitch, youtube, etc.
 	Enabled           bool                       `json:"enabled"`
 	Syncing           bool                       `json:"syncing"`
-	RoleId            uint64                     `json:"role_id,string"`
+	RoleId            utils.Snowflake            `json:"role_id"`
 	EnableEmoticons   bool                       `json:"enable_emoticons"`
 	ExpireBehaviour   IntegrationExpireBehaviour `json:"expire_behavior"`
 	ExpireGracePeriod int                        `json:"expire_grace_period"`
@@ -1,6 +1,9 @@
 package user
 
-import "fmt"
+import (
+	"fmt"
+	"github.com/rxdn/gdl/utils"
+)
 
 type (
 	CachedUser struct {
@@ -19,7 +22,7 @@ func (u *CachedUser) ToUser(userId uint64) User {
 	_ = avatar.UnmarshalJSON([]byte(fmt.Sprintf(`"%s"`, u.Avatar))) // this is quite hacky
 
 	return User{
-		Id:            userId,
+		Id:            utils.Snowflake(userId),
 		Username:      u.Username,
 		Discriminator: u.Discriminator,
 		Avatar:        avatar,
@@ -9,7 +9,7 @@ type Presence struct {
 	User         User                    `json:"user"`
 	Roles        utils.Uint64StringSlice `json:"roles,string"`
 	Game         Activity                `json:"name"`
-	GuildId      uint64                  `json:"guild_id,string"`
+	GuildId      utils.Snowflake         `json:"guild_id"`
 	Status       string                  `json:"status"`
 	Activities   []Activity              `json:"activities"`
 	ClientStatus ClientStatus            `json:"client_status"`
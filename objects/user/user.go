@@ -1,37 +1,40 @@
 package user
 
-import "fmt"
+import (
+	"fmt"
+	"github.com/rxdn/gdl/cdn"
+	"github.com/rxdn/gdl/utils"
+)
 
 type User struct {
-	Id            uint64 `json:"id,string"`
-	Username      string `json:"username"`
-	Discriminator uint16 `json:"discriminator,string"`
-	Avatar        Avatar `json:"avatar"`
-	Bot           bool   `json:"bot"`
-	MfaEnabled    bool   `json:"mfa_enabled"`
-	Locale        string `json:"locale"`
-	Verified      bool   `json:"verified"`
-	Email         string `json:"email"`
-	Flags         uint32 `json:"flags"`
-	PremiumType   int    `json:"premium_type"`
+	Id            utils.Snowflake `json:"id"`
+	Username      string          `json:"username"`
+	Discriminator uint16          `json:"discriminator,string"`
+	Avatar        Avatar          `json:"avatar"`
+	Bot           bool            `json:"bot"`
+	MfaEnabled    bool            `json:"mfa_enabled"`
+	Locale        string          `json:"locale"`
+	Verified      bool            `json:"verified"`
+	Email         string          `json:"email"`
+	Flags         uint32          `json:"flags"`
+	PremiumType   int             `json:"premium_type"`
 }
 
 // shortcut, ignores errors
 func (u *User) AvatarUrl(size int) string {
-	hash := u.Avatar.String()
 	// if blank avatar, return a blank string so that we can use omitempty
-	if len(hash) == 0 {
-		return ""
-	}
+	url, _ := u.AvatarUrlFormat(cdn.Auto, size)
+	return url
+}
 
-	var extension string
-	if u.Avatar.Animated {
-		extension = "gif"
-	} else {
-		extension = "webp"
-	}
+// AvatarUrlFormat returns cdn.ErrNoAsset if the user has no avatar, in which case DefaultAvatarUrl can be used
+func (u *User) AvatarUrlFormat(format cdn.Format, size int) (string, error) {
+	return cdn.UserAvatar(uint64(u.Id), u.Avatar.String(), format, size)
+}
 
-	return fmt.Sprintf("https://cdn.discordapp.com/avatars/%d/%s.%s?size=%d", u.Id, u.Avatar.String(), extension, size)
+// DefaultAvatarUrl returns the avatar shown when the user has not set one
+func (u *User) DefaultAvatarUrl() string {
+	return cdn.DefaultUserAvatar(u.Discriminator)
 }
 
 func (u *User) Mention() string {
@@ -8,7 +8,7 @@ func CanSelfInteractWith(shard *gateway.Shard, guildId, targetId uint64) bool {
 		return false
 	}
 
-	return CanInteractWith(shard, self.Id, guildId, targetId)
+	return CanInteractWith(shard, uint64(self.Id), guildId, targetId)
 }
 
 func CanInteractWith(shard *gateway.Shard, guildId, userId, targetId uint64) bool {
@@ -27,7 +27,7 @@ func GetHighestRolePosition(shard *gateway.Shard, guildId, userId uint64) int {
 	highest := 0 // @everyone has a position of 0
 	for _, roleId := range member.Roles {
 		for _, role := range roles {
-			if role.Id == roleId {
+			if uint64(role.Id) == roleId {
 				if role.Position > highest {
 					highest = role.Position
 				}
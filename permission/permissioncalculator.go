@@ -3,9 +3,9 @@ package permission
 import (
 	"errors"
 	"github.com/rxdn/gdl/gateway"
+	"github.com/rxdn/gdl/logging"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/guild"
-	"github.com/sirupsen/logrus"
 )
 
 func HasPermissionsChannel(shard *gateway.Shard, guildId, userId, channelId uint64, permissions ...Permission) bool {
@@ -58,7 +58,7 @@ func GetAllPermissionsChannel(shard *gateway.Shard, guildId, userId, channelId u
 	sum, err := GetEffectivePermissionsChannel(shard, guildId, userId, channelId)
 	if err != nil {
 		if shard.ShardManager.ShardOptions.Debug {
-			logrus.Infof("shard %d: error retrieving permissions: %s", shard.ShardId, err.Error())
+			shard.Logger.Info("Error retrieving permissions", logging.GuildId(guildId), logging.Err(err))
 		}
 
 		return permissions
@@ -79,7 +79,7 @@ func GetAllPermissions(shard *gateway.Shard, guildId, userId uint64) []Permissio
 	sum, err := GetEffectivePermissions(shard, guildId, userId)
 	if err != nil {
 		if shard.ShardManager.ShardOptions.Debug {
-			logrus.Infof("shard %d: error retrieving permissions: %s", shard.ShardId, err.Error())
+			shard.Logger.Info("Error retrieving permissions", logging.GuildId(guildId), logging.Err(err))
 		}
 
 		return permissions
@@ -144,7 +144,7 @@ func GetChannelMemberPermissions(shard *gateway.Shard, userId, channelId uint64,
 	}
 
 	for _, overwrite := range ch.PermissionOverwrites {
-		if overwrite.Type == channel.PermissionTypeMember && overwrite.Id == userId {
+		if overwrite.Type == channel.PermissionTypeMember && uint64(overwrite.Id) == userId {
 			initialPermissions &= ^overwrite.Deny
 			initialPermissions |= overwrite.Allow
 		}
@@ -173,7 +173,7 @@ func GetChannelTotalRolePermissions(shard *gateway.Shard, guildId, userId, chann
 
 	for _, memberRole := range member.Roles {
 		for _, role := range roles {
-			if memberRole == role.Id {
+			if memberRole == uint64(role.Id) {
 				for _, overwrite := range ch.PermissionOverwrites {
 					if overwrite.Type == channel.PermissionTypeRole && overwrite.Id == role.Id {
 						allow |= overwrite.Allow
@@ -199,7 +199,7 @@ func GetChannelBasePermissions(shard *gateway.Shard, guildId, channelId uint64,
 
 	var publicRole *guild.Role
 	for _, role := range roles {
-		if role.Id == guildId {
+		if uint64(role.Id) == guildId {
 			publicRole = &role
 			break
 		}
@@ -238,7 +238,7 @@ func GetGuildTotalRolePermissions(shard *gateway.Shard, guildId, userId uint64,
 
 	for _, memberRole := range member.Roles {
 		for _, role := range roles {
-			if memberRole == role.Id {
+			if memberRole == uint64(role.Id) {
 				initialPermissions |= role.Permissions
 			}
 		}
@@ -255,7 +255,7 @@ func GetBasePermissions(shard *gateway.Shard, guildId uint64) (int, error) {
 
 	var publicRole *guild.Role
 	for _, role := range roles {
-		if role.Id == guildId {
+		if uint64(role.Id) == guildId {
 			publicRole = &role
 			break
 		}
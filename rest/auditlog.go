@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"fmt"
+	"github.com/rxdn/gdl/objects/auditlog"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"github.com/rxdn/gdl/rest/request"
+	"net/url"
+	"strconv"
+)
+
+type GetGuildAuditLogData struct {
+	UserId     uint64                 // filter entries by the user who made the change
+	ActionType auditlog.AuditLogEvent // filter entries by type
+	Before     uint64                 // entry ID, for pagination
+	Limit      int                    // 1 - 100, defaults to 50
+}
+
+func (d *GetGuildAuditLogData) Query() string {
+	query := url.Values{}
+
+	if d.UserId != 0 {
+		query.Set("user_id", strconv.FormatUint(d.UserId, 10))
+	}
+
+	if d.ActionType != 0 {
+		query.Set("action_type", strconv.Itoa(int(d.ActionType)))
+	}
+
+	if d.Before != 0 {
+		query.Set("before", strconv.FormatUint(d.Before, 10))
+	}
+
+	if d.Limit > 100 || d.Limit < 1 {
+		d.Limit = 50
+	}
+	query.Set("limit", strconv.Itoa(d.Limit))
+
+	return query.Encode()
+}
+
+// entries are returned newest first, so to fetch the next page, set Before to the ID of the last entry
+func GetGuildAuditLog(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data GetGuildAuditLogData, opts ...request.Option) (auditlog.AuditLog, error) {
+	endpoint := request.Endpoint{
+		RequestType: request.GET,
+		ContentType: request.Nil,
+		Endpoint:    fmt.Sprintf("/guilds/%d/audit-logs?%s", guildId, data.Query()),
+		RateLimiter: rateLimiter,
+	}
+
+	var auditLog auditlog.AuditLog
+	err, _ := endpoint.Request(token, nil, &auditLog, opts...)
+	return auditLog, err
+}
@@ -1,7 +1,6 @@
 package rest
 
 import (
-	"bytes"
 	"fmt"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/channel/embed"
@@ -12,24 +11,20 @@ import (
 	"github.com/rxdn/gdl/rest/request"
 	"github.com/rxdn/gdl/utils"
 	"io"
-	"mime/multipart"
-	"net/textproto"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
-func GetChannel(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64) (channel.Channel, error) {
+func GetChannel(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, opts ...request.Option) (channel.Channel, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d", channelId),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var channel channel.Channel
-	if err, _ := endpoint.Request(token, nil, &channel); err != nil {
+	if err, _ := endpoint.Request(token, nil, &channel, opts...); err != nil {
 		return channel, err
 	}
 
@@ -48,34 +43,32 @@ type ModifyChannelData struct {
 	ParentId             uint64                        `json:"parent_id,string,omitempty"`
 }
 
-func ModifyChannel(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data ModifyChannelData) (channel.Channel, error) {
+func ModifyChannel(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data ModifyChannelData, opts ...request.Option) (channel.Channel, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/channels/%d", channelId),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var channel channel.Channel
-	if err, _ := endpoint.Request(token, data, &channel); err != nil {
+	if err, _ := endpoint.Request(token, data, &channel, opts...); err != nil {
 		return channel, err
 	}
 
 	return channel, nil
 }
 
-func DeleteChannel(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64) (channel.Channel, error) {
+func DeleteChannel(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, opts ...request.Option) (channel.Channel, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d", channelId),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var channel channel.Channel
-	if err, _ := endpoint.Request(token, nil, &channel); err != nil {
+	if err, _ := endpoint.Request(token, nil, &channel, opts...); err != nil {
 		return channel, err
 	}
 
@@ -113,112 +106,68 @@ func (o *GetChannelMessagesData) Query() string {
 	return query.Encode()
 }
 
-func GetChannelMessages(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data GetChannelMessagesData) ([]message.Message, error) {
+func GetChannelMessages(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data GetChannelMessagesData, opts ...request.Option) ([]message.Message, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages?%s", channelId, data.Query()),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var messages []message.Message
-	if err, _ := endpoint.Request(token, nil, &messages); err != nil {
+	if err, _ := endpoint.Request(token, nil, &messages, opts...); err != nil {
 		return nil, err
 	}
 
 	return messages, nil
 }
 
-func GetChannelMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64) (message.Message, error) {
+func GetChannelMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, opts ...request.Option) (message.Message, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages/%d", channelId, messageId),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var message message.Message
-	if err, _ := endpoint.Request(token, nil, &message); err != nil {
+	if err, _ := endpoint.Request(token, nil, &message, opts...); err != nil {
 		return message, err
 	}
 
 	return message, nil
 }
 
-type File struct {
-	Name        string
-	ContentType string
-	Reader      io.Reader
-}
-
 type CreateMessageData struct {
-	Content         string                 `json:"content"`
-	Nonce           string                 `json:"nonce,omitempty"`
-	Tts             bool                   `json:"tts,omitempty"`
-	File            *File                  `json:"-"`
-	Embed           *embed.Embed           `json:"embed,omitempty"`
-	PayloadJson     string                 `json:"-"` // TODO: Helper method
-	AllowedMentions message.AllowedMention `json:"allowed_mentions"`
-}
-
-func (d CreateMessageData) EncodeMultipartFormData() ([]byte, string, error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-
-	if d.File != nil {
-		fileName := d.File.Name
-		fileName = strings.Replace(fileName, "\\", "\\\\", -1)
-		fileName = strings.Replace(fileName, "\"", "\\\"", -1)
-
-		h := make(textproto.MIMEHeader)
-		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, fileName))
-		h.Set("Content-Type", d.File.ContentType)
-
-		part, err := writer.CreatePart(h)
-		if err != nil {
-			return body.Bytes(), writer.Boundary(), err
-		}
-
-		if _, err := io.Copy(part, d.File.Reader); err != nil {
-			return body.Bytes(), writer.Boundary(), err
-		}
-	}
-
-	if d.Content != "" {
-		if err := writer.WriteField("content", d.Content); err != nil {
-			return body.Bytes(), writer.Boundary(), err
-		}
-	}
-
-	if err := writer.WriteField("tts", strconv.FormatBool(d.Tts)); err != nil {
-		return body.Bytes(), writer.Boundary(), err
-	}
+	Content         string                  `json:"content"`
+	Nonce           string                  `json:"nonce,omitempty"`
+	Tts             bool                    `json:"tts,omitempty"`
+	File            *File                   `json:"-"` // deprecated: use Files
+	Files           []*File                 `json:"-"`
+	Embed           *embed.Embed            `json:"embed,omitempty"`            // files can be referenced with File.AttachmentUrl
+	PayloadJson     string                  `json:"-"`                          // overrides the JSON encoded data when uploading files
+	AllowedMentions *message.AllowedMention `json:"allowed_mentions,omitempty"` // defaults to Client.AllowedMentions
+}
 
-	if d.Nonce != "" {
-		if err := writer.WriteField("nonce", d.Nonce); err != nil {
-			return body.Bytes(), writer.Boundary(), err
-		}
-	}
+func (d CreateMessageData) files() []*File {
+	return mergeFiles(d.File, d.Files)
+}
 
-	if d.PayloadJson != "" {
-		if err := writer.WriteField("payload_json", d.PayloadJson); err != nil {
-			return body.Bytes(), writer.Boundary(), err
-		}
-	}
+func (d CreateMessageData) EncodeMultipartFormData() (io.Reader, string, error) {
+	return encodeMultipart(d, d.PayloadJson, d.files())
+}
 
-	return []byte(string(body.Bytes()) + "\r\n--" + writer.Boundary() + "--"), writer.Boundary(), nil
+func (d CreateMessageData) Rewind() error {
+	return rewindFiles(d.files())
 }
 
-func CreateMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data CreateMessageData) (message.Message, error) {
+func CreateMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data CreateMessageData, opts ...request.Option) (message.Message, error) {
 	var endpoint request.Endpoint
-	if data.File == nil {
+	if len(data.files()) == 0 {
 		endpoint = request.Endpoint{
 			RequestType: request.POST,
 			ContentType: request.ApplicationJson,
 			Endpoint:    fmt.Sprintf("/channels/%d/messages", channelId),
-			Bucket:      ratelimit.NewMessageWriteBucket(channelId),
 			RateLimiter: rateLimiter,
 		}
 	} else {
@@ -226,13 +175,12 @@ func CreateMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId u
 			RequestType: request.POST,
 			ContentType: request.MultipartFormData,
 			Endpoint:    fmt.Sprintf("/channels/%d/messages", channelId),
-			Bucket:      ratelimit.NewMessageWriteBucket(channelId),
 			RateLimiter: rateLimiter,
 		}
 	}
 
 	var message message.Message
-	if err, _ := endpoint.Request(token, data, &message); err != nil {
+	if err, _ := endpoint.Request(token, data, &message, opts...); err != nil {
 		return message, err
 	}
 
@@ -240,44 +188,41 @@ func CreateMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId u
 }
 
 // emoji is the raw unicode emoji
-func CreateReaction(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, emoji string) error {
+func CreateReaction(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, emoji string, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.PUT,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages/%d/reactions/%s/@me", channelId, messageId, url.QueryEscape(emoji)),
-		Bucket:      ratelimit.NewReactionsBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
 // emoji is the raw unicode emoji
-func DeleteOwnReaction(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, emoji string) error {
+func DeleteOwnReaction(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, emoji string, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages/%d/reactions/%s/@me", channelId, messageId, url.QueryEscape(emoji)),
-		Bucket:      ratelimit.NewReactionsBucket(channelId), // TODO: Verify this uses the reaction bucket
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
 // emoji is the raw unicode emoji
-func DeleteUserReaction(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId, userId uint64, emoji string) error {
+func DeleteUserReaction(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId, userId uint64, emoji string, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages/%d/reactions/%s/%d", channelId, messageId, url.QueryEscape(emoji), userId),
-		Bucket:      ratelimit.NewReactionsBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
@@ -306,91 +251,86 @@ func (o *GetReactionsData) Query() string {
 	return query.Encode()
 }
 
-func GetReactions(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, emoji string, data GetReactionsData) ([]user.User, error) {
+func GetReactions(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, emoji string, data GetReactionsData, opts ...request.Option) ([]user.User, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages/%d/reactions/%s?%s", channelId, messageId, url.QueryEscape(emoji), data.Query()),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var users []user.User
-	if err, _ := endpoint.Request(token, nil, &users); err != nil {
+	if err, _ := endpoint.Request(token, nil, &users, opts...); err != nil {
 		return nil, err
 	}
 
 	return users, nil
 }
 
-func DeleteAllReactions(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64) error {
+func DeleteAllReactions(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages/%d/reactions", channelId, messageId),
-		Bucket:      ratelimit.NewReactionsBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func DeleteAllReactionsEmoji(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, emoji string) error {
+func DeleteAllReactionsEmoji(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, emoji string, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages/%d/reactions/%s", channelId, messageId, url.QueryEscape(emoji)),
-		Bucket:      ratelimit.NewReactionsBucket(channelId), // Verify this endpoint uses this bucket
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
 type EditMessageData struct {
-	Content string       `json:"content,omitempty"`
-	Embed   *embed.Embed `json:"embed,omitempty"`
-	Flags   int          `json:"flags,omitempty"` // https://discord.com/developers/docs/resources/channel#message-object-message-flags TODO: Helper function
+	Content         string                  `json:"content,omitempty"`
+	Embed           *embed.Embed            `json:"embed,omitempty"`
+	Flags           int                     `json:"flags,omitempty"`            // https://discord.com/developers/docs/resources/channel#message-object-message-flags TODO: Helper function
+	AllowedMentions *message.AllowedMention `json:"allowed_mentions,omitempty"` // defaults to Client.AllowedMentions
 }
 
-func EditMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, data ModifyChannelData) (message.Message, error) {
+func EditMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, data EditMessageData, opts ...request.Option) (message.Message, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages/%d", channelId, messageId),
-		Bucket:      ratelimit.NewMessageWriteBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var message message.Message
-	if err, _ := endpoint.Request(token, data, &message); err != nil {
+	if err, _ := endpoint.Request(token, data, &message, opts...); err != nil {
 		return message, err
 	}
 
 	return message, nil
 }
 
-func DeleteMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64) error {
+func DeleteMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages/%d", channelId, messageId),
-		Bucket:      ratelimit.NewMessageDeleteBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func BulkDeleteMessages(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, messages []uint64) error {
+func BulkDeleteMessages(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, messages []uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/channels/%d/messages/bulk-delete", channelId),
-		Bucket:      ratelimit.NewBulkDeleteBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
@@ -398,36 +338,34 @@ func BulkDeleteMessages(token string, rateLimiter *ratelimit.Ratelimiter, channe
 		"messages": utils.Uint64StringSlice(messages),
 	}
 
-	err, _ := endpoint.Request(token, body, nil)
+	err, _ := endpoint.Request(token, body, nil, opts...)
 	return err
 }
 
-func EditChannelPermissions(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, updated channel.PermissionOverwrite) error {
+func EditChannelPermissions(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, updated channel.PermissionOverwrite, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.PUT,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/channels/%d/permissions/%d", channelId, updated.Id),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	updated.Id = 0
 
-	err, _ := endpoint.Request(token, updated, nil)
+	err, _ := endpoint.Request(token, updated, nil, opts...)
 	return err
 }
 
-func GetChannelInvites(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64) ([]invite.InviteMetadata, error) {
+func GetChannelInvites(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, opts ...request.Option) ([]invite.InviteMetadata, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/invites", channelId),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var invites []invite.InviteMetadata
-	if err, _ := endpoint.Request(token, nil, &invites); err != nil {
+	if err, _ := endpoint.Request(token, nil, &invites, opts...); err != nil {
 		return nil, err
 	}
 
@@ -443,88 +381,82 @@ type CreateInviteData struct {
 	TargetUserType int    `json:"target_user_type,omitempty"`
 }
 
-func CreateChannelInvite(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data CreateInviteData) (invite.Invite, error) {
+func CreateChannelInvite(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data CreateInviteData, opts ...request.Option) (invite.Invite, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/channels/%d/invites", channelId),
-		Bucket:      ratelimit.NewInviteCreateBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var invite invite.Invite
-	if err, _ := endpoint.Request(token, data, &invite); err != nil {
+	if err, _ := endpoint.Request(token, data, &invite, opts...); err != nil {
 		return invite, err
 	}
 
 	return invite, nil
 }
 
-func DeleteChannelPermissions(token string, rateLimiter *ratelimit.Ratelimiter, channelId, overwriteId uint64) error {
+func DeleteChannelPermissions(token string, rateLimiter *ratelimit.Ratelimiter, channelId, overwriteId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/channels/%d/permissions/%d", channelId, overwriteId),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func TriggerTypingIndicator(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64) error {
+func TriggerTypingIndicator(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/typing", channelId),
-		Bucket:      ratelimit.NewTypingBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func GetPinnedMessages(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64) ([]message.Message, error) {
+func GetPinnedMessages(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, opts ...request.Option) ([]message.Message, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/pins", channelId),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var messages []message.Message
-	if err, _ := endpoint.Request(token, nil, &messages); err != nil {
+	if err, _ := endpoint.Request(token, nil, &messages, opts...); err != nil {
 		return nil, err
 	}
 
 	return messages, nil
 }
 
-func AddPinnedChannelMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64) error {
+func AddPinnedChannelMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.PUT,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/pins/%d", channelId, messageId),
-		Bucket:      ratelimit.NewMessageWriteBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func DeletePinnedChannelMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64) error {
+func DeletePinnedChannelMessage(token string, rateLimiter *ratelimit.Ratelimiter, channelId, messageId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/pins/%d", channelId, messageId),
-		Bucket:      ratelimit.NewMessageWriteBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
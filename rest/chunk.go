@@ -0,0 +1,257 @@
+package rest
+
+import (
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"github.com/rxdn/gdl/rest/request"
+	"strings"
+	"unicode/utf8"
+)
+
+const codeFence = "```"
+
+// DefaultAllowedMentions is applied by CreateMessageChunked when no allowed mentions are set, so that user provided
+// text can't mention @everyone, @here or roles
+var DefaultAllowedMentions = message.NewAllowedMentions().ParseUsers()
+
+// CreateMessageChunked splits content that is too long for a single message into multiple messages. files, embeds and
+// the nonce are attached to the last message. if sending a message fails, the messages that were sent are returned
+func CreateMessageChunked(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data CreateMessageData, opts ...request.Option) ([]message.Message, error) {
+	if data.AllowedMentions == nil {
+		data.AllowedMentions = DefaultAllowedMentions
+	}
+
+	chunks := SplitContent(data.Content, message.MaxContentLength)
+
+	messages := make([]message.Message, 0, len(chunks))
+	for i, chunk := range chunks {
+		chunkData := CreateMessageData{
+			Content:         chunk,
+			Tts:             data.Tts,
+			AllowedMentions: data.AllowedMentions,
+		}
+
+		if i == len(chunks)-1 {
+			chunkData.Nonce = data.Nonce
+			chunkData.File = data.File
+			chunkData.Files = data.Files
+			chunkData.Embed = data.Embed
+			chunkData.PayloadJson = data.PayloadJson
+		}
+
+		msg, err := CreateMessage(token, rateLimiter, channelId, chunkData, opts...)
+		if err != nil {
+			return messages, err
+		}
+
+		messages = append(messages, msg)
+	}
+
+	return messages, nil
+}
+
+// SplitContent splits content into chunks of at most limit characters, breaking on lines, then words where possible.
+// code blocks that are split are closed at the end of the chunk, and reopened with the same language in the next.
+// at least one chunk is always returned, even if it is empty, so that files and embeds are still sent
+func SplitContent(content string, limit int) []string {
+	if limit <= 0 {
+		limit = message.MaxContentLength
+	}
+
+	if utf8.RuneCountInString(content) <= limit {
+		return []string{content}
+	}
+
+	splitter := contentSplitter{limit: limit, openStart: -1}
+	for _, line := range strings.Split(content, "\n") {
+		splitter.writeLine(line)
+	}
+
+	splitter.flush(true)
+
+	// content that is only whitespace produces no chunks
+	if len(splitter.chunks) == 0 {
+		return []string{""}
+	}
+
+	return splitter.chunks
+}
+
+type contentSplitter struct {
+	limit     int
+	chunks    []string
+	current   strings.Builder
+	length    int // characters in current
+	headerLen int // length of the reopened code fence at the start of current
+	inFence   bool
+	language  string
+	openLine  string // the line that opened the current code block, if nothing has been written after it
+	openStart int    // offset of openLine in current, or -1
+}
+
+func (s *contentSplitter) writeLine(line string) {
+	togglesFence := strings.Count(line, codeFence)%2 == 1
+
+	// space must be left to close the code block, if it is still open after this line
+	closeCost := 0
+	if s.inFence != togglesFence {
+		closeCost = len(codeFence) + 1
+	}
+
+	if s.fits(utf8.RuneCountInString(line) + closeCost) {
+		s.writeWholeLine(line, togglesFence)
+		return
+	}
+
+	if s.length > s.headerLen {
+		s.flush(false)
+
+		if s.fits(utf8.RuneCountInString(line) + closeCost) {
+			s.writeWholeLine(line, togglesFence)
+			return
+		}
+	}
+
+	// the line is too long to fit in a message on its own, so split it on words
+	if s.inFence || togglesFence {
+		closeCost = len(codeFence) + 1
+	}
+
+	remaining := []rune(line)
+	for len(remaining) > 0 {
+		available := s.limit - s.length - closeCost
+		if s.length > 0 {
+			available-- // newline
+		}
+
+		if available <= 0 {
+			if s.length > s.headerLen {
+				s.flush(false)
+				continue
+			}
+
+			available = 1
+		}
+
+		end := len(remaining)
+		if end > available {
+			end = available
+			if i := lastSpace(remaining[:available]); i > 0 {
+				end = i + 1
+			}
+		}
+
+		// the fence may be in any part of the line, so the block must be closed and reopened from the part it is in
+		part := string(remaining[:end])
+		s.write(part)
+		s.updateFence(part, strings.Count(part, codeFence)%2 == 1)
+		remaining = remaining[end:]
+
+		if len(remaining) > 0 {
+			s.flush(false)
+		}
+	}
+}
+
+func (s *contentSplitter) writeWholeLine(line string, togglesFence bool) {
+	opensFence := togglesFence && !s.inFence
+	start := s.current.Len()
+
+	s.write(line)
+	s.updateFence(line, togglesFence)
+
+	if opensFence {
+		s.openLine = line
+		s.openStart = start
+	}
+}
+
+func (s *contentSplitter) fits(length int) bool {
+	if s.length > 0 {
+		length++ // newline
+	}
+
+	return s.length+length <= s.limit
+}
+
+func (s *contentSplitter) write(str string) {
+	s.openStart = -1
+
+	if s.length > 0 {
+		s.current.WriteByte('\n')
+		s.length++
+	}
+
+	s.current.WriteString(str)
+	s.length += utf8.RuneCountInString(str)
+}
+
+func (s *contentSplitter) updateFence(line string, togglesFence bool) {
+	if !togglesFence {
+		return
+	}
+
+	if s.inFence {
+		s.inFence = false
+		s.language = ""
+		return
+	}
+
+	s.inFence = true
+
+	// the language can only be given on the line that opens the block, e.g. ```go
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, codeFence) {
+		language := strings.TrimPrefix(trimmed, codeFence)
+		if !strings.ContainsAny(language, " \t`") {
+			s.language = language
+		}
+	}
+}
+
+func (s *contentSplitter) flush(final bool) {
+	// a code block opened by the last line would be empty, so move the line to the next chunk instead
+	if s.openStart > 0 && !final {
+		s.appendChunk(s.current.String()[:s.openStart])
+
+		s.current.Reset()
+		s.current.WriteString(s.openLine)
+		s.length = utf8.RuneCountInString(s.openLine)
+		s.headerLen = s.length
+		s.openStart = -1
+		return
+	}
+
+	if s.inFence && !final {
+		s.current.WriteString("\n" + codeFence)
+	}
+
+	s.appendChunk(s.current.String())
+
+	s.current.Reset()
+	s.length = 0
+	s.headerLen = 0
+	s.openStart = -1
+
+	if s.inFence && !final {
+		s.current.WriteString(codeFence + s.language)
+		s.length = utf8.RuneCountInString(codeFence + s.language)
+		s.headerLen = s.length
+	}
+}
+
+func (s *contentSplitter) appendChunk(chunk string) {
+	if strings.TrimSpace(chunk) != "" {
+		s.chunks = append(s.chunks, chunk)
+	}
+}
+
+func lastSpace(runes []rune) int {
+	for i := len(runes) - 1; i >= 0; i-- {
+		if runes[i] == ' ' || runes[i] == '\t' {
+			return i
+		}
+	}
+
+	return -1
+}
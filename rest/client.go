@@ -0,0 +1,483 @@
+package rest
+
+import (
+	"github.com/rxdn/gdl/logging"
+	"github.com/rxdn/gdl/metrics"
+	"github.com/rxdn/gdl/objects/auditlog"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/objects/guild"
+	"github.com/rxdn/gdl/objects/guild/emoji"
+	"github.com/rxdn/gdl/objects/integration"
+	"github.com/rxdn/gdl/objects/invite"
+	"github.com/rxdn/gdl/objects/member"
+	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"github.com/rxdn/gdl/rest/request"
+	"github.com/rxdn/gdl/tracing"
+	"image"
+	"net/http"
+)
+
+// Client holds everything needed to make requests to the API, so that REST can be used without a ShardManager, e.g.
+// from a web dashboard. A Client is safe for concurrent use, and should be reused so that connections are pooled.
+type Client struct {
+	Token       string
+	HttpClient  *http.Client
+	BaseUrl     string
+	RateLimiter *ratelimit.Ratelimiter
+	UserAgent   string
+	RetryPolicy *request.RetryPolicy // request.DefaultRetryPolicy if created with NewClient
+	Hook        func(string)         // called with the URL of each request
+	// applied to messages, webhook messages and edits that don't set their own allowed mentions. nil to use Discord's default
+	AllowedMentions *message.AllowedMention
+	Logger          logging.Logger  // the standard logrus logger if created with NewClient
+	Metrics         metrics.Metrics // NoopMetrics if created with NewClient
+	Tracer          tracing.Tracer  // NoopTracer if created with NewClient
+}
+
+// NewClient creates a client with its own http.Client, retry policy, logger, metrics and tracer, so that it doesn't
+// depend on the deprecated package level defaults in request. rateLimiter may be nil, however, this is not
+// recommended, as requests will be sent without waiting for ratelimits.
+func NewClient(token string, rateLimiter *ratelimit.Ratelimiter) *Client {
+	retryPolicy := request.DefaultRetryPolicy
+
+	return &Client{
+		Token:       token,
+		HttpClient:  request.NewHttpClient(),
+		BaseUrl:     request.BaseUrl,
+		RateLimiter: rateLimiter,
+		UserAgent:   request.DefaultUserAgent,
+		RetryPolicy: &retryPolicy,
+		Logger:      logging.StandardLogrusLogger(),
+		Metrics:     metrics.NoopMetrics{},
+		Tracer:      tracing.NoopTracer{},
+	}
+}
+
+// options are applied before opts, so options passed to a method take priority over the client's
+func (c *Client) options(opts []request.Option) []request.Option {
+	clientOpts := make([]request.Option, 0, len(opts)+8)
+
+	if c.HttpClient != nil {
+		clientOpts = append(clientOpts, request.WithHttpClient(c.HttpClient))
+	}
+
+	if c.BaseUrl != "" {
+		clientOpts = append(clientOpts, request.WithBaseUrl(c.BaseUrl))
+	}
+
+	if c.UserAgent != "" {
+		clientOpts = append(clientOpts, request.WithUserAgent(c.UserAgent))
+	}
+
+	if c.RetryPolicy != nil {
+		clientOpts = append(clientOpts, request.WithRetryPolicy(*c.RetryPolicy))
+	}
+
+	if c.Hook != nil {
+		clientOpts = append(clientOpts, request.WithHook(c.Hook))
+	}
+
+	if c.Logger != nil {
+		clientOpts = append(clientOpts, request.WithLogger(c.Logger))
+	}
+
+	if c.Metrics != nil {
+		clientOpts = append(clientOpts, request.WithMetrics(c.Metrics))
+	}
+
+	if c.Tracer != nil {
+		clientOpts = append(clientOpts, request.WithTracer(c.Tracer))
+	}
+
+	return append(clientOpts, opts...)
+}
+
+// entries are returned newest first, so to fetch the next page, set Before to the ID of the last entry
+func (c *Client) GetGuildAuditLog(guildId uint64, data GetGuildAuditLogData, opts ...request.Option) (auditlog.AuditLog, error) {
+	return GetGuildAuditLog(c.Token, c.RateLimiter, guildId, data, c.options(opts)...)
+}
+
+func (c *Client) GetChannel(channelId uint64, opts ...request.Option) (channel.Channel, error) {
+	return GetChannel(c.Token, c.RateLimiter, channelId, c.options(opts)...)
+}
+
+func (c *Client) ModifyChannel(channelId uint64, data ModifyChannelData, opts ...request.Option) (channel.Channel, error) {
+	return ModifyChannel(c.Token, c.RateLimiter, channelId, data, c.options(opts)...)
+}
+
+func (c *Client) DeleteChannel(channelId uint64, opts ...request.Option) (channel.Channel, error) {
+	return DeleteChannel(c.Token, c.RateLimiter, channelId, c.options(opts)...)
+}
+
+func (c *Client) GetChannelMessages(channelId uint64, data GetChannelMessagesData, opts ...request.Option) ([]message.Message, error) {
+	return GetChannelMessages(c.Token, c.RateLimiter, channelId, data, c.options(opts)...)
+}
+
+func (c *Client) GetChannelMessage(channelId, messageId uint64, opts ...request.Option) (message.Message, error) {
+	return GetChannelMessage(c.Token, c.RateLimiter, channelId, messageId, c.options(opts)...)
+}
+
+func (c *Client) CreateMessage(channelId uint64, data CreateMessageData, opts ...request.Option) (message.Message, error) {
+	if data.AllowedMentions == nil {
+		data.AllowedMentions = c.AllowedMentions
+	}
+
+	return CreateMessage(c.Token, c.RateLimiter, channelId, data, c.options(opts)...)
+}
+
+func (c *Client) CreateMessageChunked(channelId uint64, data CreateMessageData, opts ...request.Option) ([]message.Message, error) {
+	if data.AllowedMentions == nil {
+		data.AllowedMentions = c.AllowedMentions
+	}
+
+	return CreateMessageChunked(c.Token, c.RateLimiter, channelId, data, c.options(opts)...)
+}
+
+// emoji is the raw unicode emoji
+func (c *Client) CreateReaction(channelId, messageId uint64, emoji string, opts ...request.Option) error {
+	return CreateReaction(c.Token, c.RateLimiter, channelId, messageId, emoji, c.options(opts)...)
+}
+
+// emoji is the raw unicode emoji
+func (c *Client) DeleteOwnReaction(channelId, messageId uint64, emoji string, opts ...request.Option) error {
+	return DeleteOwnReaction(c.Token, c.RateLimiter, channelId, messageId, emoji, c.options(opts)...)
+}
+
+// emoji is the raw unicode emoji
+func (c *Client) DeleteUserReaction(channelId, messageId, userId uint64, emoji string, opts ...request.Option) error {
+	return DeleteUserReaction(c.Token, c.RateLimiter, channelId, messageId, userId, emoji, c.options(opts)...)
+}
+
+func (c *Client) GetReactions(channelId, messageId uint64, emoji string, data GetReactionsData, opts ...request.Option) ([]user.User, error) {
+	return GetReactions(c.Token, c.RateLimiter, channelId, messageId, emoji, data, c.options(opts)...)
+}
+
+func (c *Client) DeleteAllReactions(channelId, messageId uint64, opts ...request.Option) error {
+	return DeleteAllReactions(c.Token, c.RateLimiter, channelId, messageId, c.options(opts)...)
+}
+
+func (c *Client) DeleteAllReactionsEmoji(channelId, messageId uint64, emoji string, opts ...request.Option) error {
+	return DeleteAllReactionsEmoji(c.Token, c.RateLimiter, channelId, messageId, emoji, c.options(opts)...)
+}
+
+func (c *Client) EditMessage(channelId, messageId uint64, data EditMessageData, opts ...request.Option) (message.Message, error) {
+	if data.AllowedMentions == nil {
+		data.AllowedMentions = c.AllowedMentions
+	}
+
+	return EditMessage(c.Token, c.RateLimiter, channelId, messageId, data, c.options(opts)...)
+}
+
+func (c *Client) DeleteMessage(channelId, messageId uint64, opts ...request.Option) error {
+	return DeleteMessage(c.Token, c.RateLimiter, channelId, messageId, c.options(opts)...)
+}
+
+func (c *Client) BulkDeleteMessages(channelId uint64, messages []uint64, opts ...request.Option) error {
+	return BulkDeleteMessages(c.Token, c.RateLimiter, channelId, messages, c.options(opts)...)
+}
+
+func (c *Client) EditChannelPermissions(channelId uint64, updated channel.PermissionOverwrite, opts ...request.Option) error {
+	return EditChannelPermissions(c.Token, c.RateLimiter, channelId, updated, c.options(opts)...)
+}
+
+func (c *Client) GetChannelInvites(channelId uint64, opts ...request.Option) ([]invite.InviteMetadata, error) {
+	return GetChannelInvites(c.Token, c.RateLimiter, channelId, c.options(opts)...)
+}
+
+func (c *Client) CreateChannelInvite(channelId uint64, data CreateInviteData, opts ...request.Option) (invite.Invite, error) {
+	return CreateChannelInvite(c.Token, c.RateLimiter, channelId, data, c.options(opts)...)
+}
+
+func (c *Client) DeleteChannelPermissions(channelId, overwriteId uint64, opts ...request.Option) error {
+	return DeleteChannelPermissions(c.Token, c.RateLimiter, channelId, overwriteId, c.options(opts)...)
+}
+
+func (c *Client) TriggerTypingIndicator(channelId uint64, opts ...request.Option) error {
+	return TriggerTypingIndicator(c.Token, c.RateLimiter, channelId, c.options(opts)...)
+}
+
+func (c *Client) GetPinnedMessages(channelId uint64, opts ...request.Option) ([]message.Message, error) {
+	return GetPinnedMessages(c.Token, c.RateLimiter, channelId, c.options(opts)...)
+}
+
+func (c *Client) AddPinnedChannelMessage(channelId, messageId uint64, opts ...request.Option) error {
+	return AddPinnedChannelMessage(c.Token, c.RateLimiter, channelId, messageId, c.options(opts)...)
+}
+
+func (c *Client) DeletePinnedChannelMessage(channelId, messageId uint64, opts ...request.Option) error {
+	return DeletePinnedChannelMessage(c.Token, c.RateLimiter, channelId, messageId, c.options(opts)...)
+}
+
+func (c *Client) ListGuildEmojis(guildId uint64, opts ...request.Option) ([]emoji.Emoji, error) {
+	return ListGuildEmojis(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildEmoji(guildId, emojiId uint64, opts ...request.Option) (emoji.Emoji, error) {
+	return GetGuildEmoji(c.Token, c.RateLimiter, guildId, emojiId, c.options(opts)...)
+}
+
+func (c *Client) CreateGuildEmoji(guildId uint64, data CreateEmojiData, opts ...request.Option) (emoji.Emoji, error) {
+	return CreateGuildEmoji(c.Token, c.RateLimiter, guildId, data, c.options(opts)...)
+}
+
+// updating Image is not permitted
+func (c *Client) ModifyGuildEmoji(guildId, emojiId uint64, data CreateEmojiData, opts ...request.Option) (emoji.Emoji, error) {
+	return ModifyGuildEmoji(c.Token, c.RateLimiter, guildId, emojiId, data, c.options(opts)...)
+}
+
+func (c *Client) DeleteGuildEmoji(guildId, emojiId uint64, opts ...request.Option) error {
+	return DeleteGuildEmoji(c.Token, c.RateLimiter, guildId, emojiId, c.options(opts)...)
+}
+
+// only available to bots in < 10 guilds
+func (c *Client) CreateGuild(data CreateGuildData, opts ...request.Option) (guild.Guild, error) {
+	return CreateGuild(c.Token, data, c.options(opts)...)
+}
+
+func (c *Client) GetGuild(guildId uint64, opts ...request.Option) (guild.Guild, error) {
+	return GetGuild(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildPreview(guildId uint64, opts ...request.Option) (guild.GuildPreview, error) {
+	return GetGuildPreview(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) ModifyGuild(guildId uint64, data ModifyGuildData, opts ...request.Option) (guild.Guild, error) {
+	return ModifyGuild(c.Token, c.RateLimiter, guildId, data, c.options(opts)...)
+}
+
+func (c *Client) DeleteGuild(guildId uint64, opts ...request.Option) error {
+	return DeleteGuild(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildChannels(guildId uint64, opts ...request.Option) ([]channel.Channel, error) {
+	return GetGuildChannels(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) CreateGuildChannel(guildId uint64, data CreateChannelData, opts ...request.Option) (channel.Channel, error) {
+	return CreateGuildChannel(c.Token, c.RateLimiter, guildId, data, c.options(opts)...)
+}
+
+func (c *Client) ModifyGuildChannelPositions(guildId uint64, positions []Position, opts ...request.Option) error {
+	return ModifyGuildChannelPositions(c.Token, c.RateLimiter, guildId, positions, c.options(opts)...)
+}
+
+func (c *Client) GetGuildMember(guildId, userId uint64, opts ...request.Option) (member.Member, error) {
+	return GetGuildMember(c.Token, c.RateLimiter, guildId, userId, c.options(opts)...)
+}
+
+func (c *Client) ListGuildMembers(guildId uint64, data ListGuildMembersData, opts ...request.Option) ([]member.Member, error) {
+	return ListGuildMembers(c.Token, c.RateLimiter, guildId, data, c.options(opts)...)
+}
+
+func (c *Client) ModifyGuildMember(guildId, userId uint64, data ModifyGuildMemberData, opts ...request.Option) error {
+	return ModifyGuildMember(c.Token, c.RateLimiter, guildId, userId, data, c.options(opts)...)
+}
+
+func (c *Client) ModifyCurrentUserNick(guildId uint64, nick string, opts ...request.Option) error {
+	return ModifyCurrentUserNick(c.Token, c.RateLimiter, guildId, nick, c.options(opts)...)
+}
+
+func (c *Client) AddGuildMemberRole(guildId, userId, roleId uint64, opts ...request.Option) error {
+	return AddGuildMemberRole(c.Token, c.RateLimiter, guildId, userId, roleId, c.options(opts)...)
+}
+
+func (c *Client) RemoveGuildMemberRole(guildId, userId, roleId uint64, opts ...request.Option) error {
+	return RemoveGuildMemberRole(c.Token, c.RateLimiter, guildId, userId, roleId, c.options(opts)...)
+}
+
+func (c *Client) RemoveGuildMember(guildId, userId uint64, opts ...request.Option) error {
+	return RemoveGuildMember(c.Token, c.RateLimiter, guildId, userId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildBans(guildId uint64, opts ...request.Option) ([]guild.Ban, error) {
+	return GetGuildBans(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildBan(guildId, userId uint64, opts ...request.Option) (guild.Ban, error) {
+	return GetGuildBan(c.Token, c.RateLimiter, guildId, userId, c.options(opts)...)
+}
+
+func (c *Client) CreateGuildBan(guildId, userId uint64, data CreateGuildBanData, opts ...request.Option) error {
+	return CreateGuildBan(c.Token, c.RateLimiter, guildId, userId, data, c.options(opts)...)
+}
+
+func (c *Client) RemoveGuildBan(guildId, userId uint64, opts ...request.Option) error {
+	return RemoveGuildBan(c.Token, c.RateLimiter, guildId, userId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildRoles(guildId uint64, opts ...request.Option) ([]guild.Role, error) {
+	return GetGuildRoles(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) CreateGuildRole(guildId uint64, data GuildRoleData, opts ...request.Option) (guild.Role, error) {
+	return CreateGuildRole(c.Token, c.RateLimiter, guildId, data, c.options(opts)...)
+}
+
+func (c *Client) ModifyGuildRolePositions(guildId uint64, positions []Position, opts ...request.Option) ([]guild.Role, error) {
+	return ModifyGuildRolePositions(c.Token, c.RateLimiter, guildId, positions, c.options(opts)...)
+}
+
+func (c *Client) ModifyGuildRole(guildId, roleId uint64, data GuildRoleData, opts ...request.Option) (guild.Role, error) {
+	return ModifyGuildRole(c.Token, c.RateLimiter, guildId, roleId, data, c.options(opts)...)
+}
+
+func (c *Client) DeleteGuildRole(guildId, roleId uint64, opts ...request.Option) error {
+	return DeleteGuildRole(c.Token, c.RateLimiter, guildId, roleId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildPruneCount(guildId uint64, days int, opts ...request.Option) (int, error) {
+	return GetGuildPruneCount(c.Token, c.RateLimiter, guildId, days, c.options(opts)...)
+}
+
+// computePruneCount = whether 'pruned' is returned, discouraged for large guilds
+func (c *Client) BeginGuildPrune(guildId uint64, days int, computePruneCount bool, opts ...request.Option) error {
+	return BeginGuildPrune(c.Token, c.RateLimiter, guildId, days, computePruneCount, c.options(opts)...)
+}
+
+func (c *Client) GetGuildVoiceRegions(guildId uint64, opts ...request.Option) ([]guild.VoiceRegion, error) {
+	return GetGuildVoiceRegions(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildInvites(guildId uint64, opts ...request.Option) ([]invite.InviteMetadata, error) {
+	return GetGuildInvites(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildIntegrations(guildId uint64, opts ...request.Option) ([]integration.Integration, error) {
+	return GetGuildIntegrations(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) CreateGuildIntegration(guildId uint64, data CreateIntegrationData, opts ...request.Option) error {
+	return CreateGuildIntegration(c.Token, c.RateLimiter, guildId, data, c.options(opts)...)
+}
+
+func (c *Client) ModifyGuildIntegration(guildId, integrationId uint64, data ModifyIntegrationData, opts ...request.Option) error {
+	return ModifyGuildIntegration(c.Token, c.RateLimiter, guildId, integrationId, data, c.options(opts)...)
+}
+
+func (c *Client) DeleteGuildIntegration(guildId, integrationId uint64, opts ...request.Option) error {
+	return DeleteGuildIntegration(c.Token, c.RateLimiter, guildId, integrationId, c.options(opts)...)
+}
+
+func (c *Client) SyncGuildIntegration(guildId, integrationId uint64, opts ...request.Option) error {
+	return SyncGuildIntegration(c.Token, c.RateLimiter, guildId, integrationId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildEmbed(guildId uint64, opts ...request.Option) (guild.GuildEmbed, error) {
+	return GetGuildEmbed(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) ModifyGuildEmbed(guildId uint64, data guild.GuildEmbed, opts ...request.Option) (guild.GuildEmbed, error) {
+	return ModifyGuildEmbed(c.Token, c.RateLimiter, guildId, data, c.options(opts)...)
+}
+
+// returns invite object with only "code" and "uses" fields
+func (c *Client) GetGuildVanityURL(guildId uint64, opts ...request.Option) (invite.Invite, error) {
+	return GetGuildVanityURL(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildWidgetImage(guildId uint64, style guild.WidgetStyle, opts ...request.Option) (image.Image, error) {
+	return GetGuildWidgetImage(c.Token, c.RateLimiter, guildId, style, c.options(opts)...)
+}
+
+func (c *Client) GetInvite(inviteCode string, withCounts bool, opts ...request.Option) (invite.Invite, error) {
+	return GetInvite(c.Token, c.RateLimiter, inviteCode, withCounts, c.options(opts)...)
+}
+
+func (c *Client) DeleteInvite(inviteCode string, opts ...request.Option) (invite.Invite, error) {
+	return DeleteInvite(c.Token, c.RateLimiter, inviteCode, c.options(opts)...)
+}
+
+func (c *Client) GetCurrentUser(opts ...request.Option) (user.User, error) {
+	return GetCurrentUser(c.Token, c.RateLimiter, c.options(opts)...)
+}
+
+func (c *Client) GetUser(userId uint64, opts ...request.Option) (user.User, error) {
+	return GetUser(c.Token, c.RateLimiter, userId, c.options(opts)...)
+}
+
+func (c *Client) ModifyCurrentUser(data ModifyUserData, opts ...request.Option) (user.User, error) {
+	return ModifyCurrentUser(c.Token, c.RateLimiter, data, c.options(opts)...)
+}
+
+func (c *Client) GetCurrentUserGuilds(data CurrentUserGuildsData, opts ...request.Option) ([]guild.Guild, error) {
+	return GetCurrentUserGuilds(c.Token, c.RateLimiter, data, c.options(opts)...)
+}
+
+func (c *Client) LeaveGuild(guildId uint64, opts ...request.Option) error {
+	return LeaveGuild(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) CreateDM(recipientId uint64, opts ...request.Option) (channel.Channel, error) {
+	return CreateDM(c.Token, c.RateLimiter, recipientId, c.options(opts)...)
+}
+
+func (c *Client) GetUserConnections(opts ...request.Option) ([]integration.Connection, error) {
+	return GetUserConnections(c.Token, c.RateLimiter, c.options(opts)...)
+}
+
+func (c *Client) ListVoiceRegions(opts ...request.Option) ([]guild.VoiceRegion, error) {
+	return ListVoiceRegions(c.Token, c.options(opts)...)
+}
+
+func (c *Client) CreateWebhook(channelId uint64, data WebhookData, opts ...request.Option) (guild.Webhook, error) {
+	return CreateWebhook(c.Token, c.RateLimiter, channelId, data, c.options(opts)...)
+}
+
+func (c *Client) GetChannelWebhooks(channelId uint64, opts ...request.Option) ([]guild.Webhook, error) {
+	return GetChannelWebhooks(c.Token, c.RateLimiter, channelId, c.options(opts)...)
+}
+
+func (c *Client) GetGuildWebhooks(guildId uint64, opts ...request.Option) ([]guild.Webhook, error) {
+	return GetGuildWebhooks(c.Token, c.RateLimiter, guildId, c.options(opts)...)
+}
+
+func (c *Client) GetWebhook(webhookId uint64, opts ...request.Option) (guild.Webhook, error) {
+	return GetWebhook(c.Token, c.RateLimiter, webhookId, c.options(opts)...)
+}
+
+// does not return a User object
+func (c *Client) GetWebhookWithToken(webhookId uint64, webhookToken string, opts ...request.Option) (guild.Webhook, error) {
+	return GetWebhookWithToken(webhookToken, c.RateLimiter, webhookId, c.options(opts)...)
+}
+
+func (c *Client) ModifyWebhook(webhookId uint64, data ModifyWebhookData, opts ...request.Option) (guild.Webhook, error) {
+	return ModifyWebhook(c.Token, c.RateLimiter, webhookId, data, c.options(opts)...)
+}
+
+func (c *Client) ModifyWebhookWithToken(webhookId uint64, webhookToken string, data WebhookData, opts ...request.Option) error {
+	return ModifyWebhookWithToken(webhookToken, c.RateLimiter, webhookId, data, c.options(opts)...)
+}
+
+func (c *Client) DeleteWebhook(webhookId uint64, opts ...request.Option) error {
+	return DeleteWebhook(c.Token, c.RateLimiter, webhookId, c.options(opts)...)
+}
+
+func (c *Client) DeleteWebhookWithToken(webhookId uint64, webhookToken string, opts ...request.Option) error {
+	return DeleteWebhookWithToken(webhookToken, c.RateLimiter, webhookId, c.options(opts)...)
+}
+
+// if wait=true, a message object will be returned
+func (c *Client) ExecuteWebhook(webhookId uint64, webhookToken string, wait bool, data WebhookBody, opts ...request.Option) (*message.Message, error) {
+	if data.AllowedMentions == nil {
+		data.AllowedMentions = c.AllowedMentions
+	}
+
+	return ExecuteWebhook(webhookToken, c.RateLimiter, webhookId, wait, data, c.options(opts)...)
+}
+
+// only messages sent by the webhook can be edited
+func (c *Client) EditWebhookMessage(webhookId uint64, webhookToken string, messageId uint64, data WebhookEditBody, opts ...request.Option) (message.Message, error) {
+	if data.AllowedMentions == nil {
+		data.AllowedMentions = c.AllowedMentions
+	}
+
+	return EditWebhookMessage(webhookToken, c.RateLimiter, webhookId, messageId, data, c.options(opts)...)
+}
+
+// only messages sent by the webhook can be deleted
+func (c *Client) DeleteWebhookMessage(webhookId uint64, webhookToken string, messageId uint64, opts ...request.Option) error {
+	return DeleteWebhookMessage(webhookToken, c.RateLimiter, webhookId, messageId, c.options(opts)...)
+}
@@ -8,31 +8,29 @@ import (
 	"github.com/rxdn/gdl/utils"
 )
 
-func ListGuildEmojis(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) ([]emoji.Emoji, error) {
+func ListGuildEmojis(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) ([]emoji.Emoji, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/emojis", guildId),
-		Bucket:      ratelimit.NewEmojiBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var emojis []emoji.Emoji
-	err, _ := endpoint.Request(token, nil, &emojis)
+	err, _ := endpoint.Request(token, nil, &emojis, opts...)
 	return emojis, err
 }
 
-func GetGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId, emojiId uint64) (emoji.Emoji, error) {
+func GetGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId, emojiId uint64, opts ...request.Option) (emoji.Emoji, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/emojis/%d", guildId, emojiId),
-		Bucket:      ratelimit.NewEmojiBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var emoji emoji.Emoji
-	if err, _ := endpoint.Request(token, nil, &emoji); err != nil {
+	if err, _ := endpoint.Request(token, nil, &emoji, opts...); err != nil {
 		return emoji, err
 	}
 
@@ -41,21 +39,20 @@ func GetGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId, em
 
 type CreateEmojiData struct {
 	Name  string
-	Image Image
+	Image Image    // must be at most MaxEmojiSize
 	Roles []uint64 // roles for which this emoji will be whitelisted
 }
 
-func CreateGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data CreateEmojiData) (emoji.Emoji, error) {
+func CreateGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data CreateEmojiData, opts ...request.Option) (emoji.Emoji, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
-		ContentType: data.Image.ContentType,
+		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/emojis", guildId),
-		Bucket:      ratelimit.NewEmojiBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var emoji emoji.Emoji
-	imageData, err := data.Image.Encode()
+	imageData, err := data.Image.encode(MaxEmojiSize)
 	if err != nil {
 		return emoji, err
 	}
@@ -66,7 +63,7 @@ func CreateGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId
 		"roles": utils.Uint64StringSlice(data.Roles),
 	}
 
-	if err, _ := endpoint.Request(token, body, &emoji); err != nil {
+	if err, _ := endpoint.Request(token, body, &emoji, opts...); err != nil {
 		return emoji, err
 	}
 
@@ -74,12 +71,11 @@ func CreateGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId
 }
 
 // updating Image is not permitted
-func ModifyGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId, emojiId uint64, data CreateEmojiData) (emoji.Emoji, error) {
+func ModifyGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId, emojiId uint64, data CreateEmojiData, opts ...request.Option) (emoji.Emoji, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/emojis/%d", guildId, emojiId),
-		Bucket:      ratelimit.NewEmojiBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
@@ -89,22 +85,21 @@ func ModifyGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId,
 	}
 
 	var emoji emoji.Emoji
-	if err, _ := endpoint.Request(token, body, &emoji); err != nil {
+	if err, _ := endpoint.Request(token, body, &emoji, opts...); err != nil {
 		return emoji, err
 	}
 
 	return emoji, nil
 }
 
-func DeleteGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId, emojiId uint64) error {
+func DeleteGuildEmoji(token string, rateLimiter *ratelimit.Ratelimiter, guildId, emojiId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/emojis/%d", guildId, emojiId),
-		Bucket:      ratelimit.NewEmojiBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
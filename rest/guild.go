@@ -20,7 +20,7 @@ import (
 type CreateGuildData struct {
 	Name                        string                                `json:"name"`
 	Region                      string                                `json:"region"` // voice region ID TODO: Helper function
-	Icon                        string                                `json:"icon"`
+	Icon                        *Image                                `json:"icon,omitempty"`
 	VerificationLevel           guild.VerificationLevel               `json:"verification_level"`
 	DefaultMessageNotifications guild.DefaultMessageNotificationLevel `json:"default_message_notifications"`
 	ExplicitContentFilter       guild.ExplicitContentFilterLevel      `json:"explicit_content_filter"`
@@ -32,45 +32,42 @@ type CreateGuildData struct {
 }
 
 // only available to bots in < 10 guilds
-func CreateGuild(token string, data CreateGuildData) (guild.Guild, error) {
+func CreateGuild(token string, data CreateGuildData, opts ...request.Option) (guild.Guild, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.ApplicationJson,
 		Endpoint:    "/guilds",
-		Bucket:      ratelimit.NewGuildBucket(0),
 		RateLimiter: nil,
 	}
 
 	var guild guild.Guild
-	err, _ := endpoint.Request(token, data, &guild)
+	err, _ := endpoint.Request(token, data, &guild, opts...)
 	return guild, err
 }
 
-func GetGuild(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) (guild.Guild, error) {
+func GetGuild(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) (guild.Guild, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d?with_counts=true", guildId), // TODO: Allow users to specify whether they want with_counts
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var guild guild.Guild
-	err, _ := endpoint.Request(token, nil, &guild)
+	err, _ := endpoint.Request(token, nil, &guild, opts...)
 	return guild, err
 }
 
-func GetGuildPreview(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) (guild.GuildPreview, error) {
+func GetGuildPreview(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) (guild.GuildPreview, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/preview", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var preview guild.GuildPreview
-	err, _ := endpoint.Request(token, nil, &preview)
+	err, _ := endpoint.Request(token, nil, &preview, opts...)
 	return preview, err
 }
 
@@ -82,54 +79,51 @@ type ModifyGuildData struct {
 	ExplicitContentFilter       guild.ExplicitContentFilterLevel      `json:"explicit_content_filter"`
 	AfkChannelId                uint64                                `json:"afk_channel_id,string"`
 	AfkTimeout                  int                                   `json:"afk_timeout"`
-	Icon                        string                                `json:"icon"`
+	Icon                        *Image                                `json:"icon,omitempty"` // NullImage removes the icon
 	OwnerId                     uint64                                `json:"owner_id"`
-	Splash                      string                                `json:"splash"`
-	Banner                      string                                `json:"banner"`
+	Splash                      *Image                                `json:"splash,omitempty"` // NullImage removes the splash
+	Banner                      *Image                                `json:"banner,omitempty"` // NullImage removes the banner
 	SystemChannelId             uint64                                `json:"system_channel_id"`
 	RulesChannelId              uint64                                `json:"rules_channel_id"`
 	PublicUpdatesChannelId      uint64                                `json:"public_updates_channel_id"`
 	PreferredLocale             string                                `json:"preferred_locale"`
 }
 
-func ModifyGuild(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data ModifyGuildData) (guild.Guild, error) {
+func ModifyGuild(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data ModifyGuildData, opts ...request.Option) (guild.Guild, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var guild guild.Guild
-	err, _ := endpoint.Request(token, data, &guild)
+	err, _ := endpoint.Request(token, data, &guild, opts...)
 	return guild, err
 }
 
-func DeleteGuild(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) error {
+func DeleteGuild(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func GetGuildChannels(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) ([]channel.Channel, error) {
+func GetGuildChannels(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) ([]channel.Channel, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/channels", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var channels []channel.Channel
-	err, _ := endpoint.Request(token, nil, &channels)
+	err, _ := endpoint.Request(token, nil, &channels, opts...)
 	return channels, err
 }
 
@@ -146,17 +140,16 @@ type CreateChannelData struct {
 	Nsfw                 bool                           `json:"nsfw,omitempty"`
 }
 
-func CreateGuildChannel(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data CreateChannelData) (channel.Channel, error) {
+func CreateGuildChannel(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data CreateChannelData, opts ...request.Option) (channel.Channel, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/channels", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var channel channel.Channel
-	err, _ := endpoint.Request(token, data, &channel)
+	err, _ := endpoint.Request(token, data, &channel, opts...)
 	return channel, err
 }
 
@@ -165,30 +158,28 @@ type Position struct {
 	Position  int    `json:"position"`
 }
 
-func ModifyGuildChannelPositions(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, positions []Position) error {
+func ModifyGuildChannelPositions(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, positions []Position, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/channels", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, positions, nil)
+	err, _ := endpoint.Request(token, positions, nil, opts...)
 	return err
 }
 
-func GetGuildMember(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64) (member.Member, error) {
+func GetGuildMember(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64, opts ...request.Option) (member.Member, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/members/%d", guildId, userId),
-		Bucket:      ratelimit.NewGuildMemberGetOrDeleteBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var member member.Member
-	err, _ := endpoint.Request(token, nil, &member)
+	err, _ := endpoint.Request(token, nil, &member, opts...)
 	return member, err
 }
 
@@ -212,17 +203,16 @@ func (d *ListGuildMembersData) Query() string {
 	return query.Encode()
 }
 
-func ListGuildMembers(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data ListGuildMembersData) ([]member.Member, error) {
+func ListGuildMembers(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data ListGuildMembersData, opts ...request.Option) ([]member.Member, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/members?%s", guildId, data.Query()),
-		Bucket:      ratelimit.NewGuildListMembersBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var members []member.Member
-	err, _ := endpoint.Request(token, nil, &members)
+	err, _ := endpoint.Request(token, nil, &members, opts...)
 	return members, err
 }
 
@@ -234,25 +224,23 @@ type ModifyGuildMemberData struct {
 	ChannelId uint64                   `json:"channel_id,string,omitempty"` // id of channel to move user to (if they are connected to voice)
 }
 
-func ModifyGuildMember(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64, data ModifyGuildMemberData) error {
+func ModifyGuildMember(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64, data ModifyGuildMemberData, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/members/%d", guildId, userId),
-		Bucket:      ratelimit.NewGuildMemberModifyBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, data, nil)
+	err, _ := endpoint.Request(token, data, nil, opts...)
 	return err
 }
 
-func ModifyCurrentUserNick(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, nick string) error {
+func ModifyCurrentUserNick(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, nick string, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/members/@me/nick", guildId),
-		Bucket:      ratelimit.NewModifyCurrentNickBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
@@ -260,74 +248,69 @@ func ModifyCurrentUserNick(token string, rateLimiter *ratelimit.Ratelimiter, gui
 		"nick": nick,
 	}
 
-	err, _ := endpoint.Request(token, data, nil)
+	err, _ := endpoint.Request(token, data, nil, opts...)
 	return err
 }
 
-func AddGuildMemberRole(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId, roleId uint64) error {
+func AddGuildMemberRole(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId, roleId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.PUT,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/members/%d/roles/%d", guildId, userId, roleId),
-		Bucket:      ratelimit.NewGuildMemberModifyBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func RemoveGuildMemberRole(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId, roleId uint64) error {
+func RemoveGuildMemberRole(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId, roleId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/members/%d/roles/%d", guildId, userId, roleId),
-		Bucket:      ratelimit.NewGuildMemberModifyBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func RemoveGuildMember(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64) error {
+func RemoveGuildMember(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/members/%d", guildId, userId),
-		Bucket:      ratelimit.NewGuildMemberGetOrDeleteBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func GetGuildBans(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) ([]guild.Ban, error) {
+func GetGuildBans(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) ([]guild.Ban, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/bans", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var bans []guild.Ban
-	err, _ := endpoint.Request(token, nil, &bans)
+	err, _ := endpoint.Request(token, nil, &bans, opts...)
 	return bans, err
 }
 
-func GetGuildBan(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64) (guild.Ban, error) {
+func GetGuildBan(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64, opts ...request.Option) (guild.Ban, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/bans/%d", guildId, userId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var ban guild.Ban
-	err, _ := endpoint.Request(token, nil, &ban)
+	err, _ := endpoint.Request(token, nil, &ban, opts...)
 	return ban, err
 }
 
@@ -336,43 +319,40 @@ type CreateGuildBanData struct {
 	Reason            string `json:"reason,omitempty"`
 }
 
-func CreateGuildBan(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64, data CreateGuildBanData) error {
+func CreateGuildBan(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64, data CreateGuildBanData, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.PUT,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/bans/%d", guildId, userId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, data, nil)
+	err, _ := endpoint.Request(token, data, nil, opts...)
 	return err
 }
 
-func RemoveGuildBan(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64) error {
+func RemoveGuildBan(token string, rateLimiter *ratelimit.Ratelimiter, guildId, userId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/bans/%d", guildId, userId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func GetGuildRoles(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) ([]guild.Role, error) {
+func GetGuildRoles(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) ([]guild.Role, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/roles", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var roles []guild.Role
-	err, _ := endpoint.Request(token, nil, &roles)
+	err, _ := endpoint.Request(token, nil, &roles, opts...)
 	return roles, err
 }
 
@@ -384,62 +364,58 @@ type GuildRoleData struct {
 	Mentionable *bool  `json:"mentionable,omitempty"`
 }
 
-func CreateGuildRole(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data GuildRoleData) (guild.Role, error) {
+func CreateGuildRole(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data GuildRoleData, opts ...request.Option) (guild.Role, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/roles", guildId),
-		Bucket:      ratelimit.NewRoleCreateBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var role guild.Role
-	err, _ := endpoint.Request(token, data, &role)
+	err, _ := endpoint.Request(token, data, &role, opts...)
 	return role, err
 }
 
-func ModifyGuildRolePositions(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, positions []Position) ([]guild.Role, error) {
+func ModifyGuildRolePositions(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, positions []Position, opts ...request.Option) ([]guild.Role, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/roles", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var roles []guild.Role
-	err, _ := endpoint.Request(token, positions, &roles)
+	err, _ := endpoint.Request(token, positions, &roles, opts...)
 	return roles, err
 }
 
-func ModifyGuildRole(token string, rateLimiter *ratelimit.Ratelimiter, guildId, roleId uint64, data GuildRoleData) (guild.Role, error) {
+func ModifyGuildRole(token string, rateLimiter *ratelimit.Ratelimiter, guildId, roleId uint64, data GuildRoleData, opts ...request.Option) (guild.Role, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/roles/%d", guildId, roleId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var role guild.Role
-	err, _ := endpoint.Request(token, data, &role)
+	err, _ := endpoint.Request(token, data, &role, opts...)
 	return role, err
 }
 
-func DeleteGuildRole(token string, rateLimiter *ratelimit.Ratelimiter, guildId, roleId uint64) error {
+func DeleteGuildRole(token string, rateLimiter *ratelimit.Ratelimiter, guildId, roleId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/roles/%d", guildId, roleId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func GetGuildPruneCount(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, days int) (int, error) {
+func GetGuildPruneCount(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, days int, opts ...request.Option) (int, error) {
 	if days < 1 {
 		days = 7
 	}
@@ -448,68 +424,63 @@ func GetGuildPruneCount(token string, rateLimiter *ratelimit.Ratelimiter, guildI
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/prune?days=%d", guildId, days),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var res map[string]int
-	err, _ := endpoint.Request(token, nil, &res)
+	err, _ := endpoint.Request(token, nil, &res, opts...)
 	return res["pruned"], err
 }
 
 // computePruneCount = whether 'pruned' is returned, discouraged for large guilds
-func BeginGuildPrune(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, days int, computePruneCount bool) error {
+func BeginGuildPrune(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, days int, computePruneCount bool, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/prune?days=%d&compute_prune_count=%t", guildId, days, computePruneCount),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func GetGuildVoiceRegions(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) ([]guild.VoiceRegion, error) {
+func GetGuildVoiceRegions(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) ([]guild.VoiceRegion, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/regions", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var regions []guild.VoiceRegion
-	err, _ := endpoint.Request(token, nil, &regions)
+	err, _ := endpoint.Request(token, nil, &regions, opts...)
 	return regions, err
 }
 
-func GetGuildInvites(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) ([]invite.InviteMetadata, error) {
+func GetGuildInvites(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) ([]invite.InviteMetadata, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/invites", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var invites []invite.InviteMetadata
-	err, _ := endpoint.Request(token, nil, &invites)
+	err, _ := endpoint.Request(token, nil, &invites, opts...)
 	return invites, err
 }
 
-func GetGuildIntegrations(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) ([]integration.Integration, error) {
+func GetGuildIntegrations(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) ([]integration.Integration, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/integrations", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var integrations []integration.Integration
-	err, _ := endpoint.Request(token, nil, &integrations)
+	err, _ := endpoint.Request(token, nil, &integrations, opts...)
 	return integrations, err
 }
 
@@ -518,16 +489,15 @@ type CreateIntegrationData struct {
 	Id   uint64 `json:"id,string"`
 }
 
-func CreateGuildIntegration(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data CreateIntegrationData) error {
+func CreateGuildIntegration(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data CreateIntegrationData, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/integrations", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, data, nil)
+	err, _ := endpoint.Request(token, data, nil, opts...)
 	return err
 }
 
@@ -537,98 +507,91 @@ type ModifyIntegrationData struct {
 	EnableEmoticons   bool                                   `json:"enable_emoticons"`
 }
 
-func ModifyGuildIntegration(token string, rateLimiter *ratelimit.Ratelimiter, guildId, integrationId uint64, data ModifyIntegrationData) error {
+func ModifyGuildIntegration(token string, rateLimiter *ratelimit.Ratelimiter, guildId, integrationId uint64, data ModifyIntegrationData, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/integrations/%d", guildId, integrationId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, data, nil)
+	err, _ := endpoint.Request(token, data, nil, opts...)
 	return err
 }
 
-func DeleteGuildIntegration(token string, rateLimiter *ratelimit.Ratelimiter, guildId, integrationId uint64) error {
+func DeleteGuildIntegration(token string, rateLimiter *ratelimit.Ratelimiter, guildId, integrationId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/integrations/%d", guildId, integrationId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func SyncGuildIntegration(token string, rateLimiter *ratelimit.Ratelimiter, guildId, integrationId uint64) error {
+func SyncGuildIntegration(token string, rateLimiter *ratelimit.Ratelimiter, guildId, integrationId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/guilds/%d/integrations/%d/sync", guildId, integrationId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func GetGuildEmbed(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) (guild.GuildEmbed, error) {
+func GetGuildEmbed(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) (guild.GuildEmbed, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/embed", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var embed guild.GuildEmbed
-	err, _ := endpoint.Request(token, nil, &embed)
+	err, _ := endpoint.Request(token, nil, &embed, opts...)
 	return embed, err
 }
 
-func ModifyGuildEmbed(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data guild.GuildEmbed) (guild.GuildEmbed, error) {
+func ModifyGuildEmbed(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, data guild.GuildEmbed, opts ...request.Option) (guild.GuildEmbed, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/embed", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var embed guild.GuildEmbed
-	err, _ := endpoint.Request(token, data, &embed)
+	err, _ := endpoint.Request(token, data, &embed, opts...)
 	return embed, err
 }
 
 // returns invite object with only "code" and "uses" fields
-func GetGuildVanityURL(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) (invite.Invite, error) {
+func GetGuildVanityURL(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) (invite.Invite, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/vanity-url", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var invite invite.Invite
-	err, _ := endpoint.Request(token, nil, &invite)
+	err, _ := endpoint.Request(token, nil, &invite, opts...)
 	return invite, err
 }
 
-func GetGuildWidgetImage(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, style guild.WidgetStyle) (image.Image, error) {
+func GetGuildWidgetImage(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, style guild.WidgetStyle, opts ...request.Option) (image.Image, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/widget.png?style=%s", guildId, string(style)),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, res := endpoint.Request(token, nil, nil)
+	err, res := endpoint.Request(token, nil, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
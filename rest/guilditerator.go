@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"context"
+	"github.com/rxdn/gdl/objects/guild"
+	"github.com/rxdn/gdl/rest/request"
+)
+
+// CurrentUserGuildIterator walks through the guilds the bot is in, fetching up to 100 at a time
+type CurrentUserGuildIterator struct {
+	client    *Client
+	opts      []request.Option
+	paginator paginator
+	page      []guild.Guild
+}
+
+func (c *Client) CurrentUserGuildIterator(options IteratorOptions, opts ...request.Option) *CurrentUserGuildIterator {
+	it := &CurrentUserGuildIterator{
+		client: c,
+		opts:   opts,
+	}
+
+	it.paginator = newPaginator(options, 100, it.fetch)
+	return it
+}
+
+// Next returns the next guild, or ErrIteratorDone if there are none left
+func (it *CurrentUserGuildIterator) Next(ctx context.Context) (guild.Guild, error) {
+	i, err := it.paginator.next(ctx)
+	if err != nil {
+		return guild.Guild{}, err
+	}
+
+	return it.page[i], nil
+}
+
+// All fetches every remaining guild
+func (it *CurrentUserGuildIterator) All(ctx context.Context) ([]guild.Guild, error) {
+	var items []guild.Guild
+	err := it.paginator.all(ctx, func(i int) {
+		items = append(items, it.page[i])
+	})
+
+	return items, err
+}
+
+func (it *CurrentUserGuildIterator) fetch(ctx context.Context, cursor uint64, limit int) ([]uint64, error) {
+	data := CurrentUserGuildsData{Limit: limit}
+	if it.paginator.Direction == Forwards {
+		data.After = cursor
+	} else {
+		data.Before = cursor
+	}
+
+	page, err := it.client.GetCurrentUserGuilds(data, withContext(ctx, it.opts)...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(page))
+	for i, g := range page {
+		ids[i] = uint64(g.Id)
+	}
+
+	it.page = page
+	return ids, nil
+}
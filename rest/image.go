@@ -1,30 +1,147 @@
 package rest
 
 import (
+	"bytes"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"github.com/rxdn/gdl/rest/request"
 	"io"
 	"io/ioutil"
+	"net/http"
 )
 
+const (
+	MaxEmojiSize    = 256 * 1024       // bytes
+	MaxImageSize    = 10 * 1024 * 1024 // bytes, for avatars, icons, splashes and banners
+	MaxDownloadSize = 50 * 1024 * 1024 // bytes
+)
+
+var (
+	ErrImageTooLarge        = errors.New("image is too large")
+	ErrUnsupportedImageType = errors.New("image must be a jpeg, png or gif")
+)
+
+// Image is encoded each time a request is sent, including when it is retried, so ImageReader is read from the start if
+// it implements io.Seeker. images created with NewImage keep their content, so they can always be sent again
 type Image struct {
-	ContentType request.ContentType
+	ContentType request.ContentType // detected from the content if blank
 	ImageReader io.Reader
+	data        []byte
+	null        bool
+}
+
+// NullImage can be set as an icon, splash, banner or avatar to remove it. leaving the field nil leaves it unchanged
+var NullImage = &Image{null: true}
+
+// NewImage detects the content type of data, which must be a jpeg, png or gif
+func NewImage(data []byte) (Image, error) {
+	contentType, err := detectImageType(data)
+	if err != nil {
+		return Image{}, err
+	}
+
+	return Image{
+		ContentType: contentType,
+		ImageReader: bytes.NewReader(data),
+		data:        data,
+	}, nil
+}
+
+func ImageFromFile(path string) (Image, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Image{}, err
+	}
+
+	return NewImage(data)
+}
+
+// ImageFromUrl downloads an image, e.g. from the CDN, so that it can be uploaded again
+func ImageFromUrl(url string, opts ...request.Option) (Image, error) {
+	data, err := DownloadAsset(url, opts...)
+	if err != nil {
+		return Image{}, err
+	}
+
+	return NewImage(data)
+}
+
+// DownloadAsset fetches a URL, e.g. one built by the cdn package, through the shared HTTP client. no token is sent
+func DownloadAsset(url string, opts ...request.Option) ([]byte, error) {
+	options := request.ApplyOptions(opts...)
+
+	req, err := http.NewRequestWithContext(options.Context, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.UserAgent != "" {
+		req.Header.Set("User-Agent", options.UserAgent)
+	}
+
+	res, err := options.HttpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, fmt.Errorf("error downloading %s: status %d", url, res.StatusCode)
+	}
+
+	return readLimited(res.Body, MaxDownloadSize)
+}
+
+func (c *Client) DownloadAsset(url string, opts ...request.Option) ([]byte, error) {
+	return DownloadAsset(url, c.options(opts)...)
 }
 
 func (i *Image) Encode() (string, error) {
-	content, err := ioutil.ReadAll(i.ImageReader)
+	return i.encode(MaxImageSize)
+}
+
+func (i *Image) encode(maxSize int) (string, error) {
+	content, err := i.read(maxSize)
 	if err != nil {
 		return "", err
 	}
 
+	contentType := i.ContentType
+	if contentType == "" {
+		if contentType, err = detectImageType(content); err != nil {
+			return "", err
+		}
+	}
+
 	encoded := base64.StdEncoding.EncodeToString(content)
 
-	return fmt.Sprintf("data:%s;base64,%s", string(i.ContentType), encoded), nil
+	return fmt.Sprintf("data:%s;base64,%s", string(contentType), encoded), nil
+}
+
+func (i *Image) read(maxSize int) ([]byte, error) {
+	if i.data != nil {
+		if len(i.data) > maxSize {
+			return nil, ErrImageTooLarge
+		}
+
+		return i.data, nil
+	}
+
+	if seeker, ok := i.ImageReader.(io.Seeker); ok {
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	return readLimited(i.ImageReader, maxSize)
 }
 
 func (i Image) MarshalJSON() ([]byte, error) {
+	if i.null {
+		return []byte("null"), nil
+	}
+
 	imageData, err := i.Encode(); if err != nil {
 		return nil, err
 	}
@@ -32,3 +149,25 @@ func (i Image) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf("\"%s\"", imageData)), nil
 }
 
+func detectImageType(data []byte) (request.ContentType, error) {
+	switch contentType := request.ContentType(http.DetectContentType(data)); contentType {
+	case request.ImageJpeg, request.ImagePng, request.ImageGif:
+		return contentType, nil
+	default:
+		return "", ErrUnsupportedImageType
+	}
+}
+
+// readLimited returns ErrImageTooLarge rather than reading more than maxSize bytes
+func readLimited(reader io.Reader, maxSize int) ([]byte, error) {
+	content, err := ioutil.ReadAll(io.LimitReader(reader, int64(maxSize)+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) > maxSize {
+		return nil, ErrImageTooLarge
+	}
+
+	return content, nil
+}
@@ -7,30 +7,28 @@ import (
 	"github.com/rxdn/gdl/rest/request"
 )
 
-func GetInvite(token string, rateLimiter *ratelimit.Ratelimiter, inviteCode string, withCounts bool) (invite.Invite, error) {
+func GetInvite(token string, rateLimiter *ratelimit.Ratelimiter, inviteCode string, withCounts bool, opts ...request.Option) (invite.Invite, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/invites/%s?with_counts=%v", inviteCode, withCounts),
-		Bucket:      ratelimit.NewInviteGetBucket(inviteCode),
 		RateLimiter: rateLimiter,
 	}
 
 	var invite invite.Invite
-	err, _ := endpoint.Request(token, nil, &invite)
+	err, _ := endpoint.Request(token, nil, &invite, opts...)
 	return invite, err
 }
 
-func DeleteInvite(token string, rateLimiter *ratelimit.Ratelimiter, inviteCode string) (invite.Invite, error) {
+func DeleteInvite(token string, rateLimiter *ratelimit.Ratelimiter, inviteCode string, opts ...request.Option) (invite.Invite, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/invites/%s", inviteCode),
-		Bucket:      inviteCode, // TODO: Figure out better way to do this
 		RateLimiter: rateLimiter,
 	}
 
 	var invite invite.Invite
-	err, _ := endpoint.Request(token, nil, &invite)
+	err, _ := endpoint.Request(token, nil, &invite, opts...)
 	return invite, err
 }
@@ -0,0 +1,208 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"github.com/rxdn/gdl/rest/request"
+	"github.com/rxdn/gdl/utils"
+	"sort"
+	"time"
+)
+
+// ErrIteratorDone is returned by Next when there are no more items
+var ErrIteratorDone = errors.New("no more items in iterator")
+
+type Direction int
+
+const (
+	Backwards Direction = iota // newest to oldest
+	Forwards                   // oldest to newest
+)
+
+type IteratorOptions struct {
+	Direction Direction
+	Cursor    uint64    // ID to start from (exclusive). if 0, starts from the newest item going backwards, or the oldest going forwards
+	Limit     int       // max number of items to return, 0 for no limit
+	Since     time.Time // only return items created after this time
+	Until     time.Time // only return items created before this time
+}
+
+// pageFetcher fetches up to limit items from the cursor, keeping them for the iterator to return, and returns their IDs
+// in the order they were received
+type pageFetcher func(ctx context.Context, cursor uint64, limit int) ([]uint64, error)
+
+// paginator keeps track of the cursor, limit and time bounds for an iterator, and which item of the current page should
+// be returned next. requests go through the client, so the ratelimiter is respected between pages.
+type paginator struct {
+	IteratorOptions
+	fetchPage   pageFetcher
+	maxPageSize int
+	cursor      uint64
+	returned    int
+	lastPage    bool     // discord returned less than we asked for, so there are no more pages
+	finished    bool     // the limit or a time bound has been reached
+	ids         []uint64 // IDs of the current page
+	order       []int    // indexes into the current page that haven't been returned yet, in the order to return them
+}
+
+func newPaginator(options IteratorOptions, maxPageSize int, fetchPage pageFetcher) paginator {
+	cursor := options.Cursor
+	if cursor == 0 {
+		if options.Direction == Backwards {
+			// without before, some endpoints, such as reactions and guilds, return the oldest page rather than the newest.
+			// a minute is added to allow for clock skew
+			until := time.Now().Add(time.Minute)
+			if !options.Until.IsZero() {
+				until = options.Until.Add(time.Millisecond)
+			}
+
+			cursor = utils.TimeToSnowflake(until)
+		} else {
+			// after=0 is omitted from the query, which would return the newest items
+			cursor = 1
+			if !options.Since.IsZero() {
+				cursor = utils.TimeToSnowflake(options.Since)
+			}
+		}
+	}
+
+	return paginator{
+		IteratorOptions: options,
+		fetchPage:       fetchPage,
+		maxPageSize:     maxPageSize,
+		cursor:          cursor,
+	}
+}
+
+// next returns the index in the current page of the next item, or ErrIteratorDone if there are none left
+func (p *paginator) next(ctx context.Context) (int, error) {
+	for {
+		if len(p.order) == 0 {
+			if !p.hasNext() {
+				return 0, ErrIteratorDone
+			}
+
+			if err := p.fetch(ctx); err != nil {
+				return 0, err
+			}
+
+			continue
+		}
+
+		i := p.order[0]
+		p.order = p.order[1:]
+
+		if p.accept(p.ids[i]) {
+			return i, nil
+		}
+
+		if p.finished {
+			return 0, ErrIteratorDone
+		}
+	}
+}
+
+// all calls add with the index of every remaining item, fetching pages as they are needed
+func (p *paginator) all(ctx context.Context, add func(i int)) error {
+	for {
+		i, err := p.next(ctx)
+		if err == ErrIteratorDone {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		add(i)
+	}
+}
+
+func (p *paginator) fetch(ctx context.Context) error {
+	limit := p.pageSize()
+
+	ids, err := p.fetchPage(ctx, p.cursor, limit)
+	if err != nil {
+		return err
+	}
+
+	order := make([]int, len(ids))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.Slice(order, func(i, j int) bool {
+		return p.less(ids[order[i]], ids[order[j]])
+	})
+
+	if len(ids) < limit {
+		p.lastPage = true
+	}
+
+	if len(order) > 0 {
+		p.cursor = ids[order[len(order)-1]]
+	}
+
+	p.ids = ids
+	p.order = order
+	return nil
+}
+
+// pageSize returns how many items to request in the next page
+func (p *paginator) pageSize() int {
+	if p.Limit > 0 && p.Limit-p.returned < p.maxPageSize {
+		return p.Limit - p.returned
+	}
+
+	return p.maxPageSize
+}
+
+// less sorts pages in the order items should be returned
+func (p *paginator) less(a, b uint64) bool {
+	if p.Direction == Forwards {
+		return a < b
+	}
+
+	return a > b
+}
+
+// accept returns whether the item should be returned, marking the iterator as finished if a bound has been passed
+func (p *paginator) accept(id uint64) bool {
+	if p.Limit > 0 && p.returned >= p.Limit {
+		p.finished = true
+		return false
+	}
+
+	created := utils.SnowflakeToTime(id)
+	if !p.Since.IsZero() && created.Before(p.Since) {
+		if p.Direction == Backwards {
+			p.finished = true
+		}
+
+		return false
+	}
+
+	if !p.Until.IsZero() && created.After(p.Until) {
+		if p.Direction == Forwards {
+			p.finished = true
+		}
+
+		return false
+	}
+
+	p.returned++
+	return true
+}
+
+// hasNext returns whether there may be more items to fetch once the buffer has been drained
+func (p *paginator) hasNext() bool {
+	if p.Limit > 0 && p.returned >= p.Limit {
+		return false
+	}
+
+	return !p.finished && !p.lastPage
+}
+
+func withContext(ctx context.Context, opts []request.Option) []request.Option {
+	withCtx := make([]request.Option, len(opts), len(opts)+1)
+	copy(withCtx, opts)
+	return append(withCtx, request.WithContext(ctx))
+}
@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"context"
+	"github.com/rxdn/gdl/objects/member"
+	"github.com/rxdn/gdl/rest/request"
+)
+
+// MemberIterator walks through the members of a guild in order of user ID, fetching up to 1000 at a time. Requires
+// the GUILD_MEMBERS intent.
+type MemberIterator struct {
+	client    *Client
+	guildId   uint64
+	opts      []request.Option
+	paginator paginator
+	page      []member.Member
+}
+
+// members can only be listed forwards, so options.Direction is ignored
+func (c *Client) MemberIterator(guildId uint64, options IteratorOptions, opts ...request.Option) *MemberIterator {
+	options.Direction = Forwards
+
+	it := &MemberIterator{
+		client:  c,
+		guildId: guildId,
+		opts:    opts,
+	}
+
+	it.paginator = newPaginator(options, 1000, it.fetch)
+	return it
+}
+
+// Next returns the next member, or ErrIteratorDone if there are none left
+func (it *MemberIterator) Next(ctx context.Context) (member.Member, error) {
+	i, err := it.paginator.next(ctx)
+	if err != nil {
+		return member.Member{}, err
+	}
+
+	return it.page[i], nil
+}
+
+// All fetches every remaining member
+func (it *MemberIterator) All(ctx context.Context) ([]member.Member, error) {
+	var items []member.Member
+	err := it.paginator.all(ctx, func(i int) {
+		items = append(items, it.page[i])
+	})
+
+	return items, err
+}
+
+func (it *MemberIterator) fetch(ctx context.Context, cursor uint64, limit int) ([]uint64, error) {
+	data := ListGuildMembersData{
+		Limit: limit,
+		After: cursor,
+	}
+
+	page, err := it.client.ListGuildMembers(it.guildId, data, withContext(ctx, it.opts)...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(page))
+	for i, m := range page {
+		ids[i] = uint64(m.User.Id)
+	}
+
+	it.page = page
+	return ids, nil
+}
@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"context"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/rest/request"
+)
+
+// MessageIterator walks through the messages in a channel, fetching up to 100 at a time
+type MessageIterator struct {
+	client    *Client
+	channelId uint64
+	opts      []request.Option
+	paginator paginator
+	page      []message.Message
+}
+
+func (c *Client) MessageIterator(channelId uint64, options IteratorOptions, opts ...request.Option) *MessageIterator {
+	it := &MessageIterator{
+		client:    c,
+		channelId: channelId,
+		opts:      opts,
+	}
+
+	it.paginator = newPaginator(options, 100, it.fetch)
+	return it
+}
+
+// Next returns the next message, or ErrIteratorDone if there are none left
+func (it *MessageIterator) Next(ctx context.Context) (message.Message, error) {
+	i, err := it.paginator.next(ctx)
+	if err != nil {
+		return message.Message{}, err
+	}
+
+	return it.page[i], nil
+}
+
+// All fetches every remaining message
+func (it *MessageIterator) All(ctx context.Context) ([]message.Message, error) {
+	var items []message.Message
+	err := it.paginator.all(ctx, func(i int) {
+		items = append(items, it.page[i])
+	})
+
+	return items, err
+}
+
+func (it *MessageIterator) fetch(ctx context.Context, cursor uint64, limit int) ([]uint64, error) {
+	data := GetChannelMessagesData{Limit: limit}
+	if it.paginator.Direction == Forwards {
+		data.After = cursor
+	} else {
+		data.Before = cursor
+	}
+
+	page, err := it.client.GetChannelMessages(it.channelId, data, withContext(ctx, it.opts)...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(page))
+	for i, msg := range page {
+		ids[i] = uint64(msg.Id)
+	}
+
+	it.page = page
+	return ids, nil
+}
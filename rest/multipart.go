@@ -0,0 +1,129 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+)
+
+type File struct {
+	Name        string
+	ContentType string // defaults to application/octet-stream
+	Reader      io.Reader
+	Spoiler     bool
+}
+
+// ErrNotRewindable is returned by Rewind if a file's Reader is not an io.Seeker
+var ErrNotRewindable = errors.New("file reader does not implement io.Seeker")
+
+// FileName returns the name the file will be uploaded with, prefixed with SPOILER_ if the file is a spoiler
+func (f *File) FileName() string {
+	if f.Spoiler && !strings.HasPrefix(f.Name, "SPOILER_") {
+		return "SPOILER_" + f.Name
+	}
+
+	return f.Name
+}
+
+// AttachmentUrl can be used in embeds to reference the file, e.g. as the image URL
+func (f *File) AttachmentUrl() string {
+	return "attachment://" + f.FileName()
+}
+
+// multipartReader is the read end of the pipe that the files are written to. Close waits for the writer to stop, so that
+// the files aren't being read when they are rewound
+type multipartReader struct {
+	*io.PipeReader
+	done chan struct{}
+}
+
+func (r *multipartReader) Close() error {
+	err := r.PipeReader.Close()
+	<-r.done
+	return err
+}
+
+// encodeMultipart streams the files through a pipe, so that they don't need to be read into memory. the rest of the
+// body is sent as payload_json.
+func encodeMultipart(payload interface{}, payloadJson string, files []*File) (io.Reader, string, error) {
+	if payloadJson == "" {
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return nil, "", err
+		}
+
+		payloadJson = string(encoded)
+	}
+
+	reader, writer := io.Pipe()
+	multipartWriter := multipart.NewWriter(writer)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		writer.CloseWithError(writeMultipart(multipartWriter, payloadJson, files))
+	}()
+
+	return &multipartReader{PipeReader: reader, done: done}, multipartWriter.Boundary(), nil
+}
+
+func writeMultipart(writer *multipart.Writer, payloadJson string, files []*File) error {
+	if err := writer.WriteField("payload_json", payloadJson); err != nil {
+		return err
+	}
+
+	for i, file := range files {
+		fileName := file.FileName()
+		fileName = strings.Replace(fileName, "\\", "\\\\", -1)
+		fileName = strings.Replace(fileName, "\"", "\\\"", -1)
+
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file%d"; filename="%s"`, i, fileName))
+		h.Set("Content-Type", contentType)
+
+		part, err := writer.CreatePart(h)
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.Copy(part, file.Reader); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+// rewindFiles seeks every file back to the start, so that the body can be encoded again
+func rewindFiles(files []*File) error {
+	for _, file := range files {
+		seeker, ok := file.Reader.(io.Seeker)
+		if !ok {
+			return ErrNotRewindable
+		}
+
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergeFiles supports the deprecated single File field alongside Files
+func mergeFiles(file *File, files []*File) []*File {
+	if file == nil {
+		return files
+	}
+
+	return append([]*File{file}, files...)
+}
@@ -0,0 +1,170 @@
+package rest
+
+import (
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/rest/request"
+	"strings"
+	"time"
+)
+
+const (
+	// BulkDeleteMaxAge is the age after which messages can no longer be bulk deleted
+	BulkDeleteMaxAge = time.Hour * 24 * 14
+
+	bulkDeleteMinMessages = 2
+	bulkDeleteMaxMessages = 100
+
+	// leave some room for clock drift and the time taken to collect a batch
+	bulkDeleteAgeMargin = time.Minute
+)
+
+// PurgeFilter decides which messages PurgeMessages deletes. zero value fields are ignored, and a message must match
+// every field that is set.
+type PurgeFilter struct {
+	AuthorIds      []uint64
+	Contains       string // case insensitive
+	Since          time.Time
+	Until          time.Time
+	HasAttachments *bool
+	IncludePinned  bool
+	Custom         func(message.Message) bool
+}
+
+func (f *PurgeFilter) Matches(msg message.Message) bool {
+	if msg.Pinned && !f.IncludePinned {
+		return false
+	}
+
+	if len(f.AuthorIds) > 0 {
+		found := false
+		for _, authorId := range f.AuthorIds {
+			if uint64(msg.Author.Id) == authorId {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	created := msg.Id.Time()
+	if (!f.Since.IsZero() && created.Before(f.Since)) || (!f.Until.IsZero() && created.After(f.Until)) {
+		return false
+	}
+
+	if f.Contains != "" && !strings.Contains(strings.ToLower(msg.Content), strings.ToLower(f.Contains)) {
+		return false
+	}
+
+	if f.HasAttachments != nil && (len(msg.Attachments) > 0) != *f.HasAttachments {
+		return false
+	}
+
+	if f.Custom != nil && !f.Custom(msg) {
+		return false
+	}
+
+	return true
+}
+
+type PurgeResult struct {
+	Scanned  int
+	Deleted  int
+	Failures []PurgeFailure
+}
+
+type PurgeFailure struct {
+	MessageIds []uint64
+	Error      error
+}
+
+// PurgeMessages pages backwards through the channel history, deleting up to limit messages that match the filter. If
+// limit is 0, the entire channel history is scanned. Messages newer than 14 days are deleted in batches of up to 100,
+// older messages are deleted one at a time. Failed deletions are reported in the result, rather than stopping the
+// purge; an error is only returned if fetching the history fails.
+func (c *Client) PurgeMessages(channelId uint64, filter PurgeFilter, limit int, opts ...request.Option) (PurgeResult, error) {
+	ctx := request.ApplyOptions(opts...).Context
+
+	it := c.MessageIterator(channelId, IteratorOptions{
+		Direction: Backwards,
+		Since:     filter.Since,
+		Until:     filter.Until,
+	}, opts...)
+
+	var result PurgeResult
+	var batch []uint64
+	var matched int
+
+	for limit <= 0 || matched < limit {
+		msg, err := it.Next(ctx)
+		if err == ErrIteratorDone {
+			break
+		} else if err != nil {
+			c.purgeBatch(channelId, batch, &result, opts)
+			return result, err
+		}
+
+		result.Scanned++
+
+		if !filter.Matches(msg) {
+			continue
+		}
+
+		matched++
+
+		if time.Since(msg.Id.Time()) > BulkDeleteMaxAge-bulkDeleteAgeMargin {
+			// history is walked backwards, so every message from here on is too old to bulk delete
+			c.purgeBatch(channelId, batch, &result, opts)
+			batch = nil
+
+			c.purgeSingle(channelId, uint64(msg.Id), &result, opts)
+			continue
+		}
+
+		batch = append(batch, uint64(msg.Id))
+		if len(batch) == bulkDeleteMaxMessages {
+			c.purgeBatch(channelId, batch, &result, opts)
+			batch = nil
+		}
+	}
+
+	c.purgeBatch(channelId, batch, &result, opts)
+	return result, nil
+}
+
+func (c *Client) purgeBatch(channelId uint64, batch []uint64, result *PurgeResult, opts []request.Option) {
+	if len(batch) == 0 {
+		return
+	}
+
+	// bulk delete requires at least 2 messages
+	if len(batch) < bulkDeleteMinMessages {
+		for _, messageId := range batch {
+			c.purgeSingle(channelId, messageId, result, opts)
+		}
+
+		return
+	}
+
+	if err := c.BulkDeleteMessages(channelId, batch, opts...); err != nil {
+		result.Failures = append(result.Failures, PurgeFailure{
+			MessageIds: batch,
+			Error:      err,
+		})
+	} else {
+		result.Deleted += len(batch)
+	}
+}
+
+func (c *Client) purgeSingle(channelId, messageId uint64, result *PurgeResult, opts []request.Option) {
+	if err := c.DeleteMessage(channelId, messageId, opts...); err != nil {
+		result.Failures = append(result.Failures, PurgeFailure{
+			MessageIds: []uint64{messageId},
+			Error:      err,
+		})
+	} else {
+		result.Deleted++
+	}
+}
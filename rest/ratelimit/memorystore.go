@@ -13,12 +13,20 @@ type MemoryStore struct {
 
 	bucketLock     sync.RWMutex
 	gatewayBuckets []*ratelimit.Bucket
+
+	hashLock     sync.RWMutex
+	bucketHashes map[string]string // route -> X-RateLimit-Bucket
+
+	globalLock   sync.Mutex
+	globalReset  time.Time
+	globalBucket *ratelimit.Bucket
 }
 
 func NewMemoryStore() *MemoryStore {
 	cache := ttlcache.NewCache()
 	return &MemoryStore{
-		Cache:          cache,
+		Cache:        cache,
+		bucketHashes: make(map[string]string),
 	}
 }
 
@@ -44,10 +52,55 @@ func (s *MemoryStore) getTTLAndDecrease(endpoint string) (time.Duration, error)
 	}
 }
 
+// UpdateRateLimit only lowers remaining within a window, like RedisStore, so that responses received out of order can't
+// raise it
 func (s *MemoryStore) UpdateRateLimit(endpoint string, remaining int, resetAfter time.Duration) {
 	s.Lock()
+	defer s.Unlock()
+
+	if item, found, _ := s.Cache.GetItem(endpoint); found && time.Until(item.ExpireAt) > 0 && remaining >= item.Data.(int) {
+		return
+	}
+
 	s.Cache.SetWithTTL(endpoint, remaining, resetAfter)
-	s.Unlock()
+}
+
+func (s *MemoryStore) getBucketHash(route string) (string, error) {
+	s.hashLock.RLock()
+	defer s.hashLock.RUnlock()
+	return s.bucketHashes[route], nil
+}
+
+func (s *MemoryStore) setBucketHash(route, hash string) error {
+	s.hashLock.Lock()
+	s.bucketHashes[route] = hash
+	s.hashLock.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) getGlobalTTL() (time.Duration, error) {
+	s.globalLock.Lock()
+	defer s.globalLock.Unlock()
+
+	return time.Until(s.globalReset), nil
+}
+
+func (s *MemoryStore) UpdateGlobalRateLimit(retryAfter time.Duration) {
+	s.globalLock.Lock()
+	s.globalReset = time.Now().Add(retryAfter)
+	s.globalLock.Unlock()
+}
+
+func (s *MemoryStore) takeGlobalToken(limit int) (time.Duration, error) {
+	s.globalLock.Lock()
+	if s.globalBucket == nil || s.globalBucket.Capacity() != int64(limit) {
+		s.globalBucket = ratelimit.NewBucketWithQuantum(time.Second, int64(limit), int64(limit))
+	}
+
+	bucket := s.globalBucket
+	s.globalLock.Unlock()
+
+	return bucket.Take(1), nil
 }
 
 func (s *MemoryStore) identifyWait(shardId int, largeShardingBuckets int) error {
@@ -0,0 +1,27 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreUpdateOnlyLowersRemaining(t *testing.T) {
+	store := NewMemoryStore()
+
+	store.UpdateRateLimit("bucket", 5, time.Second*10)
+	store.UpdateRateLimit("bucket", 3, time.Second*10)
+	store.UpdateRateLimit("bucket", 4, time.Second*10) // arrived out of order
+
+	if remaining, _ := store.Cache.Get("bucket"); remaining != 3 {
+		t.Fatalf("expected remaining to stay at 3, got %v", remaining)
+	}
+
+	// a new window may raise the count again
+	store.UpdateRateLimit("window", 1, time.Millisecond*50)
+	time.Sleep(time.Millisecond * 100)
+	store.UpdateRateLimit("window", 4, time.Second*10)
+
+	if remaining, _ := store.Cache.Get("window"); remaining != 4 {
+		t.Fatalf("expected remaining to be 4 in the new window, got %v", remaining)
+	}
+}
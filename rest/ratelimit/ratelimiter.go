@@ -1,37 +1,162 @@
 package ratelimit
 
 import (
+	"context"
+	"fmt"
+	"github.com/rxdn/gdl/metrics"
+	"github.com/rxdn/gdl/tracing"
 	"sync"
 	"time"
 )
 
+// GlobalBucket is the route used in metrics for time spent waiting on the global ratelimit
+const GlobalBucket = "global"
+
 // Big thanks to https://github.com/spencersharkey for sharing his ratelimiter with me
 
 type Ratelimiter struct {
 	sync.Mutex
 	Store                RateLimitStore
+	Metrics              metrics.Metrics
+	Tracer               tracing.Tracer
+	GlobalLimit          int // if set, requests will be limited to this many per second before discord returns a 429
 	largeShardingBuckets int
 }
 
 func NewRateLimiter(store RateLimitStore, largeShardingBuckets int) *Ratelimiter {
 	return &Ratelimiter{
 		Store:                store,
+		Metrics:              metrics.NoopMetrics{},
+		Tracer:               tracing.NoopTracer{},
 		largeShardingBuckets: largeShardingBuckets,
 	}
 }
 
-func (l *Ratelimiter) ExecuteCall(bucket string, ch chan error) {
-	ttl, err := l.Store.getTTLAndDecrease(bucket)
-	if err != nil { // if an error occurred, we should cancel the request
-		ch <- err
-		return
+// ExecuteCall waits until a request can be made to the route. route should contain the method and route template, e.g.
+// "GET /channels/:id/messages", and majorParameter the channel, guild or webhook the request is for
+func (l *Ratelimiter) ExecuteCall(route, majorParameter string, ch chan error) {
+	l.ExecuteCallWithContext(context.Background(), route, majorParameter, ch)
+}
+
+// ExecuteCallWithContext stops waiting if ctx is cancelled, sending ctx.Err() to ch
+func (l *Ratelimiter) ExecuteCallWithContext(ctx context.Context, route, majorParameter string, ch chan error) {
+	_, span := l.Tracer.StartSpan(ctx, tracing.SpanRateLimitWait, tracing.String(tracing.KeyRoute, route))
+
+	err := l.wait(ctx, route, majorParameter, span)
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	span.End()
+	ch <- err
+}
+
+// UpdateRateLimit stores the ratelimit returned by discord. routes that share a bucket hash will share a ratelimit
+func (l *Ratelimiter) UpdateRateLimit(route, majorParameter, hash string, remaining int, resetAfter time.Duration) error {
+	if hash != "" {
+		if err := l.Store.setBucketHash(route, hash); err != nil {
+			return err
+		}
+	}
+
+	bucket, err := l.bucketKey(route, majorParameter)
+	if err != nil {
+		return err
+	}
+
+	l.Store.UpdateRateLimit(bucket, remaining, resetAfter)
+	return nil
+}
+
+// bucketKey returns the key ratelimits are stored under. until discord has told us which bucket the route belongs to,
+// the route is treated as its own bucket
+func (l *Ratelimiter) bucketKey(route, majorParameter string) (string, error) {
+	hash, err := l.Store.getBucketHash(route)
+	if err != nil {
+		return "", err
+	}
+
+	if hash == "" {
+		return fmt.Sprintf("%s:%s", route, majorParameter), nil
+	}
+
+	return fmt.Sprintf("%s:%s", hash, majorParameter), nil
+}
+
+func (l *Ratelimiter) wait(ctx context.Context, route, majorParameter string, span tracing.Span) error {
+	if err := l.waitGlobal(ctx); err != nil {
+		return err
+	}
+
+	bucket, err := l.bucketKey(route, majorParameter)
+	if err != nil {
+		return err
+	}
+
+	span.SetAttributes(tracing.String(tracing.KeyBucket, bucket))
+
+	for {
+		ttl, err := l.Store.getTTLAndDecrease(bucket)
+		if err != nil { // if an error occurred, we should cancel the request
+			return err
+		}
+
+		if ttl <= 0 {
+			return nil
+		}
+
+		l.Metrics.RateLimitWait(route, ttl)
+
+		if err := sleepContext(ctx, ttl); err != nil {
+			return err
+		}
+	}
+}
+
+// waitGlobal blocks while we are globally ratelimited, and then takes a token from the proactive limiter if enabled
+func (l *Ratelimiter) waitGlobal(ctx context.Context) error {
+	for {
+		ttl, err := l.Store.getGlobalTTL()
+		if err != nil {
+			return err
+		}
+
+		if ttl <= 0 {
+			break
+		}
+
+		l.Metrics.RateLimitWait(GlobalBucket, ttl)
+		if err := sleepContext(ctx, ttl); err != nil {
+			return err
+		}
+	}
+
+	if l.GlobalLimit <= 0 {
+		return nil
+	}
+
+	ttl, err := l.Store.takeGlobalToken(l.GlobalLimit)
+	if err != nil {
+		return err
 	}
 
 	if ttl > 0 {
-		<-time.After(ttl)
-		l.ExecuteCall(bucket, ch)
-	} else {
-		ch <- nil
+		l.Metrics.RateLimitWait(GlobalBucket, ttl)
+		return sleepContext(ctx, ttl)
+	}
+
+	return nil
+}
+
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
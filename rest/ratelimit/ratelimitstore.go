@@ -4,8 +4,16 @@ import "time"
 
 const IdentifyWait = 6 * time.Second
 
+// DefaultGlobalLimit is the number of requests per second Discord allows across all routes
+const DefaultGlobalLimit = 50
+
 type RateLimitStore interface {
 	getTTLAndDecrease(bucket string) (time.Duration, error)
 	UpdateRateLimit(bucket string, remaining int, resetAfter time.Duration)
+	getBucketHash(route string) (string, error)
+	setBucketHash(route, hash string) error
+	getGlobalTTL() (time.Duration, error)
+	UpdateGlobalRateLimit(retryAfter time.Duration)
+	takeGlobalToken(limit int) (time.Duration, error)
 	identifyWait(shardId int, largeShardingBuckets int) error
 }
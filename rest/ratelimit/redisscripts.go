@@ -0,0 +1,71 @@
+package ratelimit
+
+import "github.com/go-redis/redis"
+
+// scripts are run atomically by redis, so multiple processes sharing a store can't both take the last request in a
+// bucket. redis.Script uses EVALSHA, falling back to EVAL if the script hasn't been loaded yet.
+
+// KEYS[1] = bucket, KEYS[2] = global lock
+// returns the number of milliseconds to wait before retrying, or 0 if the request may be made
+var acquireScript = redis.NewScript(`
+local globalTtl = redis.call('PTTL', KEYS[2])
+if globalTtl > 0 then
+	return globalTtl
+end
+
+local remaining = tonumber(redis.call('GET', KEYS[1]))
+if remaining == nil then
+	return 0
+end
+
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl <= 0 then
+	return 0
+end
+
+if remaining > 0 then
+	redis.call('DECR', KEYS[1])
+	return 0
+end
+
+return ttl
+`)
+
+// KEYS[1] = bucket, ARGV[1] = remaining, ARGV[2] = reset after in milliseconds
+// responses can arrive out of order, so within a window we only ever lower the remaining count. once the window has
+// expired, the key is gone and the next response starts a new one.
+var updateScript = redis.NewScript(`
+local remaining = tonumber(ARGV[1])
+local current = tonumber(redis.call('GET', KEYS[1]))
+
+if current == nil or redis.call('PTTL', KEYS[1]) <= 0 or remaining < current then
+	redis.call('SET', KEYS[1], remaining, 'PX', ARGV[2])
+end
+
+return 0
+`)
+
+// KEYS[1] = global lock, ARGV[1] = retry after in milliseconds
+// only extends the lock, so a stale 429 can't shorten it
+var globalLockScript = redis.NewScript(`
+if redis.call('PTTL', KEYS[1]) < tonumber(ARGV[1]) then
+	redis.call('SET', KEYS[1], 1, 'PX', ARGV[1])
+end
+
+return 0
+`)
+
+// KEYS[1] = counter for the current second, ARGV[1] = limit
+// returns 1 if the request may be made
+var globalTokenScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], 2000)
+end
+
+if count > tonumber(ARGV[1]) then
+	return 0
+end
+
+return 1
+`)
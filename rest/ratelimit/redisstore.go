@@ -3,7 +3,6 @@ package ratelimit
 import (
 	"fmt"
 	"github.com/go-redis/redis"
-	"strconv"
 	"time"
 )
 
@@ -20,34 +19,83 @@ func NewRedisStore(client *redis.Client, keyPrefix string) *RedisStore {
 }
 
 func (s *RedisStore) getTTLAndDecrease(endpoint string) (time.Duration, error) {
-	key := fmt.Sprintf("%s:%s", s.keyPrefix, endpoint)
+	keys := []string{s.bucketKey(endpoint), s.globalKey()}
 
-	remainingStr, err := s.Get(key).Result()
+	wait, err := acquireScript.Run(s.Client, keys).Int64()
 	if err != nil {
-		if err == redis.Nil { // if the key isn't found, then we can't be ratelimited yet
-			return 0, nil
-		} else { // an actual error occurred
-			return 0, err
-		}
+		return 0, err
+	}
+
+	return time.Duration(wait) * time.Millisecond, nil
+}
+
+func (s *RedisStore) UpdateRateLimit(endpoint string, remaining int, resetAfter time.Duration) {
+	// PX must be positive
+	if resetAfter < time.Millisecond {
+		return
 	}
 
-	remaining, err := strconv.Atoi(remainingStr)
-	if err != nil { // some unknown error occurred
+	keys := []string{s.bucketKey(endpoint)}
+	updateScript.Run(s.Client, keys, remaining, resetAfter.Milliseconds()) // doesn't matter too much if it errors
+}
+
+func (s *RedisStore) getBucketHash(route string) (string, error) {
+	key := fmt.Sprintf("%s:buckets", s.keyPrefix)
+
+	hash, err := s.HGet(key, route).Result()
+	if err == redis.Nil { // we haven't made a request to this route yet
+		return "", nil
+	}
+
+	return hash, err
+}
+
+func (s *RedisStore) setBucketHash(route, hash string) error {
+	key := fmt.Sprintf("%s:buckets", s.keyPrefix)
+	return s.HSet(key, route, hash).Err()
+}
+
+func (s *RedisStore) getGlobalTTL() (time.Duration, error) {
+	ttl, err := s.PTTL(s.globalKey()).Result()
+	if err != nil && err != redis.Nil {
 		return 0, err
 	}
 
-	s.Decr(key) // attempt to decrease, doesn't matter too much if it errors
+	// PTTL returns a negative value if the key does not exist
+	return ttl, nil
+}
+
+func (s *RedisStore) UpdateGlobalRateLimit(retryAfter time.Duration) {
+	if retryAfter < time.Millisecond {
+		return
+	}
+
+	globalLockScript.Run(s.Client, []string{s.globalKey()}, retryAfter.Milliseconds())
+}
+
+func (s *RedisStore) takeGlobalToken(limit int) (time.Duration, error) {
+	now := time.Now()
+	key := fmt.Sprintf("%s:%d", s.globalKey(), now.Unix())
 
-	if remaining > 0 {
+	allowed, err := globalTokenScript.Run(s.Client, []string{key}, limit).Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	if allowed == 1 {
 		return 0, nil
-	} else { // if we're out of requests, we need to check the TTL of the key
-		return s.PTTL(key).Result()
 	}
+
+	// wait until the next second, when a new key will be used
+	return now.Truncate(time.Second).Add(time.Second).Sub(now), nil
 }
 
-func (s *RedisStore) UpdateRateLimit(endpoint string, remaining int, resetAfter time.Duration) {
-	key := fmt.Sprintf("%s:%s", s.keyPrefix, endpoint)
-	s.Set(key, remaining, resetAfter)
+func (s *RedisStore) bucketKey(bucket string) string {
+	return fmt.Sprintf("%s:%s", s.keyPrefix, bucket)
+}
+
+func (s *RedisStore) globalKey() string {
+	return fmt.Sprintf("%s:global", s.keyPrefix)
 }
 
 func (s *RedisStore) identifyWait(shardId int, largeShardingBuckets int) error {
@@ -0,0 +1,156 @@
+package ratelimit
+
+import (
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestRedisStore(t *testing.T) (*RedisStore, *miniredis.Miniredis) {
+	t.Helper()
+
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewRedisStore(client, "test"), server
+}
+
+func TestAcquireRace(t *testing.T) {
+	store, _ := newTestRedisStore(t)
+	store.UpdateRateLimit("bucket", 1, time.Second*10)
+
+	var wg sync.WaitGroup
+	waits := make([]time.Duration, 2)
+	for i := range waits {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			wait, err := store.getTTLAndDecrease("bucket")
+			if err != nil {
+				t.Error(err)
+			}
+			waits[i] = wait
+		}(i)
+	}
+	wg.Wait()
+
+	var allowed int
+	for _, wait := range waits {
+		if wait == 0 {
+			allowed++
+		}
+	}
+
+	if allowed != 1 {
+		t.Fatalf("expected exactly 1 acquirer to be allowed, got %d (waits: %v)", allowed, waits)
+	}
+}
+
+func TestAcquireUnknownBucket(t *testing.T) {
+	store, _ := newTestRedisStore(t)
+
+	wait, err := store.getTTLAndDecrease("unknown")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wait != 0 {
+		t.Fatalf("expected no wait for a bucket without a limit, got %s", wait)
+	}
+}
+
+func TestAcquireWaitsForGlobalLock(t *testing.T) {
+	store, _ := newTestRedisStore(t)
+	store.UpdateRateLimit("bucket", 5, time.Second*10)
+	store.UpdateGlobalRateLimit(time.Second * 3)
+
+	wait, err := store.getTTLAndDecrease("bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wait != time.Second*3 {
+		t.Fatalf("expected to wait for the global lock, got %s", wait)
+	}
+}
+
+func TestUpdateOnlyLowersRemaining(t *testing.T) {
+	store, server := newTestRedisStore(t)
+	key := store.bucketKey("bucket")
+
+	store.UpdateRateLimit("bucket", 5, time.Second*10)
+	store.UpdateRateLimit("bucket", 3, time.Second*10)
+	store.UpdateRateLimit("bucket", 4, time.Second*10) // arrived out of order
+
+	if remaining, _ := server.Get(key); remaining != "3" {
+		t.Fatalf("expected remaining to stay at 3, got %s", remaining)
+	}
+
+	// a new window may raise the count again
+	server.FastForward(time.Second * 11)
+	store.UpdateRateLimit("bucket", 4, time.Second*10)
+
+	if remaining, _ := server.Get(key); remaining != "4" {
+		t.Fatalf("expected remaining to be 4 in the new window, got %s", remaining)
+	}
+}
+
+func TestGlobalLockOnlyExtended(t *testing.T) {
+	store, server := newTestRedisStore(t)
+	key := store.globalKey()
+
+	store.UpdateGlobalRateLimit(time.Second * 5)
+	store.UpdateGlobalRateLimit(time.Second) // stale 429
+
+	if ttl := server.TTL(key); ttl != time.Second*5 {
+		t.Fatalf("expected the lock to not be shortened, got %s", ttl)
+	}
+
+	store.UpdateGlobalRateLimit(time.Second * 10)
+
+	if ttl := server.TTL(key); ttl != time.Second*10 {
+		t.Fatalf("expected the lock to be extended, got %s", ttl)
+	}
+}
+
+func TestGlobalTokens(t *testing.T) {
+	// the key changes each second, so retry with a new store if the second ticks over mid test
+	for attempt := 0; attempt < 3; attempt++ {
+		store, _ := newTestRedisStore(t)
+		start := time.Now().Unix()
+
+		var waits []time.Duration
+		for i := 0; i < 3; i++ {
+			wait, err := store.takeGlobalToken(2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			waits = append(waits, wait)
+		}
+
+		if time.Now().Unix() != start {
+			continue
+		}
+
+		if waits[0] != 0 || waits[1] != 0 {
+			t.Fatalf("expected the first 2 tokens to be taken, got waits %v", waits)
+		}
+
+		if waits[2] <= 0 || waits[2] > time.Second {
+			t.Fatalf("expected the third token to wait until the next second, got %s", waits[2])
+		}
+
+		return
+	}
+
+	t.Skip("second ticked over during every attempt")
+}
@@ -0,0 +1,74 @@
+package rest
+
+import (
+	"context"
+	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/rest/request"
+)
+
+// ReactionIterator walks through the users who reacted to a message with an emoji, fetching up to 100 at a time
+type ReactionIterator struct {
+	client    *Client
+	channelId uint64
+	messageId uint64
+	emoji     string
+	opts      []request.Option
+	paginator paginator
+	page      []user.User
+}
+
+// emoji is the raw unicode emoji
+func (c *Client) ReactionIterator(channelId, messageId uint64, emoji string, options IteratorOptions, opts ...request.Option) *ReactionIterator {
+	it := &ReactionIterator{
+		client:    c,
+		channelId: channelId,
+		messageId: messageId,
+		emoji:     emoji,
+		opts:      opts,
+	}
+
+	it.paginator = newPaginator(options, 100, it.fetch)
+	return it
+}
+
+// Next returns the next user, or ErrIteratorDone if there are none left
+func (it *ReactionIterator) Next(ctx context.Context) (user.User, error) {
+	i, err := it.paginator.next(ctx)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	return it.page[i], nil
+}
+
+// All fetches every remaining user
+func (it *ReactionIterator) All(ctx context.Context) ([]user.User, error) {
+	var items []user.User
+	err := it.paginator.all(ctx, func(i int) {
+		items = append(items, it.page[i])
+	})
+
+	return items, err
+}
+
+func (it *ReactionIterator) fetch(ctx context.Context, cursor uint64, limit int) ([]uint64, error) {
+	data := GetReactionsData{Limit: limit}
+	if it.paginator.Direction == Forwards {
+		data.After = cursor
+	} else {
+		data.Before = cursor
+	}
+
+	page, err := it.client.GetReactions(it.channelId, it.messageId, it.emoji, data, withContext(ctx, it.opts)...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(page))
+	for i, u := range page {
+		ids[i] = uint64(u.Id)
+	}
+
+	it.page = page
+	return ids, nil
+}
@@ -1,5 +1,7 @@
 package request
 
+import "io"
+
 type ContentType string
 
 const (
@@ -13,5 +15,12 @@ const (
 )
 
 type MultipartData interface {
-	EncodeMultipartFormData() ([]byte, string, error)
+	// EncodeMultipartFormData returns a reader for the body, and the boundary used. if the reader is an io.Closer, it is
+	// closed once the request has been sent, and must not return until the body is no longer being written
+	EncodeMultipartFormData() (io.Reader, string, error)
+}
+
+// Rewinder is implemented by MultipartData whose body can be encoded again, allowing the request to be retried
+type Rewinder interface {
+	Rewind() error
 }
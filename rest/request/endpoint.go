@@ -2,25 +2,66 @@ package request
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/pasztorpisti/qs"
+	"github.com/rxdn/gdl/logging"
+	"github.com/rxdn/gdl/metrics"
 	"github.com/rxdn/gdl/rest/ratelimit"
-	"github.com/sirupsen/logrus"
+	"github.com/rxdn/gdl/tracing"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 )
 
-const BASE_URL = "https://discord.com/api/v6"
+const API_PATH = "/api/v6"
+const BASE_URL = "https://discord.com" + API_PATH
+
+// BaseUrl is the URL requests are sent to. It can be changed to send requests through a proxy, such as cmd/gdl-proxy
+var BaseUrl = BASE_URL
+
+const DefaultUserAgent = "DiscordBot (https://github.com/rxdn/gdl, 1)"
+
+// HttpClient is shared between requests that don't specify their own client, so that connections are reused
+var HttpClient = NewHttpClient()
+
+// NewHttpClient creates a client with timeouts for connecting and waiting for a response, but not for sending the
+// request body, so that large uploads aren't cut off. requests can still be cancelled through their context
+func NewHttpClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{
+		Timeout:   3 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	transport.TLSHandshakeTimeout = 3 * time.Second
+	transport.ResponseHeaderTimeout = 10 * time.Second
+
+	return &http.Client{
+		Transport: transport,
+	}
+}
+
+// ProxyBaseUrl returns the base URL for a proxy, such as cmd/gdl-proxy, e.g. http://localhost:8080
+func ProxyBaseUrl(proxyUrl string) string {
+	return strings.TrimSuffix(proxyUrl, "/") + API_PATH
+}
+
+// UseProxy sends all requests through a proxy, such as cmd/gdl-proxy, e.g. http://localhost:8080
+func UseProxy(proxyUrl string) {
+	BaseUrl = ProxyBaseUrl(proxyUrl)
+}
 
 type Endpoint struct {
 	RequestType       RequestType
 	ContentType       ContentType
 	Endpoint          string
-	Bucket            string
 	RateLimiter       *ratelimit.Ratelimiter
 	AdditionalHeaders map[string]string
 }
@@ -30,47 +71,111 @@ type ResponseWithContent struct {
 	Content []byte
 }
 
-// figure out a better way to do this
+// Hook is used by requests that don't set their own.
+//
+// Deprecated: set rest.Client.Hook, or pass WithHook, as this is shared by every client.
 var Hook func(string)
 
-func (e *Endpoint) Request(token string, body interface{}, response interface{}) (error, *ResponseWithContent) {
-	url := BASE_URL + e.Endpoint
+// Metrics is used by requests that don't set their own, if it is not nil.
+//
+// Deprecated: set rest.Client.Metrics, or pass WithMetrics, as this is shared by every client.
+var Metrics metrics.Metrics
 
-	if Hook != nil {
-		Hook(url)
-	}
+// Logger is used by requests that don't set their own, if it is not nil.
+//
+// Deprecated: set rest.Client.Logger, or pass WithLogger, as this is shared by every client.
+var Logger logging.Logger
 
-	// Ratelimit
-	if e.RateLimiter != nil {
-		ch := make(chan error)
-		go e.RateLimiter.ExecuteCall(e.Bucket, ch)
-		if err := <-ch; err != nil {
+// Tracer is used by requests that don't set their own, if it is not nil.
+//
+// Deprecated: set rest.Client.Tracer, or pass WithTracer, as this is shared by every client.
+var Tracer tracing.Tracer
+
+// Retry is used by requests that don't set their own retry policy.
+//
+// Deprecated: set rest.Client.RetryPolicy, or pass WithRetryPolicy, as this is shared by every client.
+var Retry = DefaultRetryPolicy
+
+func (e *Endpoint) Request(token string, body interface{}, response interface{}, opts ...Option) (error, *ResponseWithContent) {
+	options := ApplyOptions(opts...)
+
+	ctx, span := options.Tracer.StartSpan(options.Context, tracing.SpanRestRequest,
+		tracing.String(tracing.KeyHttpMethod, string(e.RequestType)),
+		tracing.String(tracing.KeyRoute, e.Route()),
+	)
+	defer span.End()
+
+	policy := *options.RetryPolicy
+
+	var rateLimitRetries, retries int
+	for {
+		err, res := e.request(ctx, options, token, body, response)
+		if err == nil {
+			return nil, res
+		}
+
+		delay, retry, rateLimited := policy.retryDelay(e.RequestType, err, rateLimitRetries, retries)
+
+		// streamed bodies can only be sent again if they can be rewound
+		if retry && e.ContentType == MultipartFormData {
+			rewinder, ok := body.(Rewinder)
+			retry = ok && rewinder.Rewind() == nil
+		}
+
+		if !retry {
+			span.RecordError(err)
+			return err, res
+		}
+
+		if rateLimited {
+			rateLimitRetries++
+			options.Logger.Warn("Hit ratelimit, retrying",
+				logging.Route(e.Route()),
+				logging.Any("retry_after", delay),
+			)
+		} else {
+			retries++
+			options.Logger.Debug("Request failed, retrying",
+				logging.Route(e.Route()),
+				logging.Err(err),
+				logging.Any("attempt", retries),
+			)
+		}
+
+		span.SetAttributes(tracing.Int(tracing.KeyRetries, rateLimitRetries+retries))
+
+		if err := sleepContext(ctx, delay); err != nil {
+			span.RecordError(err)
 			return err, nil
 		}
 	}
+}
+
+func (e *Endpoint) request(ctx context.Context, options Options, token string, body interface{}, response interface{}) (error, *ResponseWithContent) {
+	url := options.BaseUrl + e.Endpoint
 
 	// Create req
 	var req *http.Request
 	var err error
 	if body == nil || e.ContentType == Nil {
-		req, err = http.NewRequest(string(e.RequestType), url, nil)
+		req, err = http.NewRequestWithContext(ctx, string(e.RequestType), url, nil)
 	} else {
 		contentType := string(e.ContentType)
 
 		// Encode body
-		var encoded []byte
+		var encoded io.Reader
 		if e.ContentType == ApplicationJson {
 			raw, err := json.Marshal(body)
 			if err != nil {
 				return err, nil
 			}
-			encoded = raw
+			encoded = bytes.NewReader(raw)
 		} else if e.ContentType == ApplicationFormUrlEncoded {
 			str, err := qs.Marshal(body)
 			if err != nil {
 				return err, nil
 			}
-			encoded = []byte(str)
+			encoded = strings.NewReader(str)
 		} else if e.ContentType == MultipartFormData {
 			data, ok := body.(MultipartData)
 			if !ok {
@@ -83,85 +188,142 @@ func (e *Endpoint) Request(token string, body interface{}, response interface{})
 				return err, nil
 			}
 
+			// wait for the body to stop being written before returning, so that it can be rewound for a retry
+			if closer, ok := encoded.(io.Closer); ok {
+				defer closer.Close()
+			}
+
 			contentType = fmt.Sprintf("%s; boundary=%s", MultipartFormData, boundary)
 		}
 
-		buff := bytes.NewBuffer(encoded)
-		req, err = http.NewRequest(string(e.RequestType), url, buff)
-		req.Header.Set("Content-Type", contentType)
+		req, err = http.NewRequestWithContext(ctx, string(e.RequestType), url, encoded)
+		if err == nil {
+			req.Header.Set("Content-Type", contentType)
+		}
+	}
+
+	if err != nil {
+		return err, nil
 	}
 
+	res, content, err := e.do(ctx, options, token, req)
 	if err != nil {
 		return err, nil
 	}
 
+	if res.StatusCode < 200 || res.StatusCode > 226 {
+		bucket := res.Header.Get("X-RateLimit-Bucket")
+		restError := newRestError(res.StatusCode, res.Header, content, e.Route(), bucket)
+		if _, ok := errorCodes[res.StatusCode]; !ok {
+			options.Logger.Warn("Unknown HTTP status",
+				logging.Status(res.StatusCode),
+				logging.Route(e.Route()),
+				logging.Bucket(bucket),
+				logging.Any("body", string(content)),
+			)
+		}
+
+		return restError, nil
+	}
+
+	if response != nil {
+		return json.Unmarshal(content, response), &ResponseWithContent{
+			Response: res,
+			Content:  content,
+		}
+	} else {
+		return nil, &ResponseWithContent{
+			Response: res,
+			Content:  content,
+		}
+	}
+}
+
+// do waits for the ratelimit, sends the request and applies the ratelimit headers from the response
+func (e *Endpoint) do(ctx context.Context, options Options, token string, req *http.Request) (*http.Response, []byte, error) {
+	if options.Hook != nil {
+		options.Hook(req.URL.String())
+	}
+
+	// Ratelimit
+	if e.RateLimiter != nil {
+		ch := make(chan error)
+		go e.RateLimiter.ExecuteCallWithContext(ctx, e.RateLimitRoute(), e.MajorParameter(), ch)
+		if err := <-ch; err != nil {
+			// the body may be streamed by a goroutine, which will be blocked until the body is read or closed
+			if req.Body != nil {
+				req.Body.Close()
+			}
+
+			return nil, nil, err
+		}
+	}
+
 	if token != "" {
 		req.Header.Set("Authorization", fmt.Sprintf("Bot %s", token))
 	}
 
 	req.Header.Set("X-RateLimit-Precision", "millisecond")
 
+	if options.UserAgent != "" {
+		req.Header.Set("User-Agent", options.UserAgent)
+	}
+
 	for key, value := range e.AdditionalHeaders {
 		req.Header.Set(key, value)
 	}
 
-	client := &http.Client{}
-	client.Timeout = 3 * time.Second
+	// discord expects the reason to be url encoded, so that it can contain non ascii characters
+	if options.Reason != "" {
+		req.Header.Set("X-Audit-Log-Reason", url.PathEscape(options.Reason))
+	}
 
-	res, err := client.Do(req)
+	_, httpSpan := options.Tracer.StartSpan(ctx, tracing.SpanRestHttp)
+	start := time.Now()
+	res, err := options.HttpClient.Do(req)
 	if err != nil {
-		return err, nil
+		options.Metrics.RestRequest(string(e.RequestType), e.Route(), 0, time.Since(start))
+		httpSpan.RecordError(err)
+		httpSpan.End()
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
+	options.Metrics.RestRequest(string(e.RequestType), e.Route(), res.StatusCode, time.Since(start))
+	httpSpan.SetAttributes(tracing.Int(tracing.KeyHttpStatusCode, res.StatusCode))
+	httpSpan.End()
+
 	if e.RateLimiter != nil {
-		e.applyNewRatelimits(res.Header)
+		e.applyNewRatelimits(options, res.Header)
 	}
 
 	content, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return err, nil
-	}
-
-	if res.StatusCode < 200 || res.StatusCode > 226 {
-		err, ok := errorCodes[res.StatusCode]
-		if !ok {
-			err = ErrUnknown
-			logrus.Warnf("http status %d at %s: %s", res.StatusCode, e.Endpoint, string(content))
-		}
-
-		return err, nil
+		return nil, nil, err
 	}
 
-	if response != nil {
-		return json.Unmarshal(content, response), &ResponseWithContent{
-			Response: res,
-			Content:  content,
-		}
-	} else {
-		return nil, &ResponseWithContent{
-			Response: res,
-			Content:  content,
-		}
-	}
+	return res, content, nil
 }
 
-func (e *Endpoint) applyNewRatelimits(header http.Header) {
-	// TODO: Global limit
-	/*// check global limit
+func (e *Endpoint) applyNewRatelimits(options Options, header http.Header) {
+	// a global 429 will not carry bucket headers
 	if global, err := strconv.ParseBool(header.Get("X-RateLimit-Global")); err == nil && global {
-		if retryAfter, err := strconv.ParseInt(header.Get("Retry-After"), 10, 64); err == nil {
-			ratelimiter.RouteManager.Lock()
-			ratelimiter.RouteManager.GlobalRetryAfter = utils.GetCurrentTimeMillis() + retryAfter
-			ratelimiter.RouteManager.Unlock()
-			ratelimiter.Unlock()
-			return
+		if retryAfter, err := strconv.ParseFloat(header.Get("Retry-After"), 64); err == nil {
+			e.RateLimiter.Store.UpdateGlobalRateLimit(time.Duration(retryAfter * float64(time.Second)))
+			options.Logger.Warn("Hit global ratelimit", logging.Route(e.Route()), logging.Any("retry_after", retryAfter))
 		}
-	}*/
+
+		return
+	}
 
 	if remaining, err := strconv.Atoi(header.Get("X-Ratelimit-Remaining")); err == nil {
 		if resetAfterSeconds, err := strconv.ParseFloat(header.Get("X-Ratelimit-Reset-After"), 32); err == nil {
-			e.RateLimiter.Store.UpdateRateLimit(e.Bucket, remaining, time.Duration(resetAfterSeconds*1000)*time.Millisecond)
+			resetAfter := time.Duration(resetAfterSeconds*1000) * time.Millisecond
+			hash := header.Get("X-RateLimit-Bucket")
+
+			if err := e.RateLimiter.UpdateRateLimit(e.RateLimitRoute(), e.MajorParameter(), hash, remaining, resetAfter); err != nil {
+				options.Logger.Warn("Error whilst updating ratelimit", logging.Route(e.Route()), logging.Bucket(hash), logging.Err(err))
+			}
 		}
 	}
 }
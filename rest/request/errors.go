@@ -1,6 +1,15 @@
 package request
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type (
 	ClientError error
@@ -36,12 +45,189 @@ var (
 	}
 )
 
+// RestError is returned when Discord responds with a non 2xx status code. It unwraps to one of the sentinel errors
+// above, so errors.Is(err, ErrNotFound) continues to work.
+type RestError struct {
+	StatusCode int
+	Code       JsonErrorCode
+	Message    string
+	Errors     json.RawMessage // nested field errors, present on 400s
+	Route      string
+	Bucket     string
+	RetryAfter time.Duration // set on 429s
+	Scope      string        // X-RateLimit-Scope, set on 429s: user, global or shared
+}
+
+// FieldError is a single validation error from the nested errors object
+type FieldError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type restErrorBody struct {
+	Code    JsonErrorCode   `json:"code"`
+	Message string          `json:"message"`
+	Errors  json.RawMessage `json:"errors"`
+
+	RetryAfter float64 `json:"retry_after"` // seconds, set on 429s
+}
+
+func newRestError(statusCode int, header http.Header, body []byte, route, bucket string) *RestError {
+	err := &RestError{
+		StatusCode: statusCode,
+		Route:      route,
+		Bucket:     bucket,
+	}
+
+	if statusCode == 429 {
+		err.Scope = header.Get("X-RateLimit-Scope")
+
+		// prefer reset-after, as it has millisecond precision
+		if resetAfter, parseErr := strconv.ParseFloat(header.Get("X-RateLimit-Reset-After"), 64); parseErr == nil {
+			err.RetryAfter = time.Duration(resetAfter * float64(time.Second))
+		} else if retryAfter, parseErr := strconv.ParseFloat(header.Get("Retry-After"), 64); parseErr == nil {
+			err.RetryAfter = time.Duration(retryAfter * float64(time.Second))
+		}
+	}
+
+	// the body is not always json, e.g. a 502 from cloudflare
+	var decoded restErrorBody
+	if json.Unmarshal(body, &decoded) == nil {
+		err.Code = decoded.Code
+		err.Message = decoded.Message
+		err.Errors = decoded.Errors
+
+		// e.g. global ratelimits, which may not have the headers
+		if statusCode == 429 && err.RetryAfter == 0 && decoded.RetryAfter > 0 {
+			err.RetryAfter = time.Duration(decoded.RetryAfter * float64(time.Second))
+		}
+	}
+
+	return err
+}
+
+func (e *RestError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(e.Unwrap().Error())
+
+	if e.Message != "" {
+		sb.WriteString(fmt.Sprintf(": %s (%d)", e.Message, e.Code))
+	}
+
+	if e.Route != "" {
+		sb.WriteString(fmt.Sprintf(" [%s]", e.Route))
+	}
+
+	fieldErrors := e.FieldErrors()
+	if len(fieldErrors) > 0 {
+		fields := make([]string, 0, len(fieldErrors))
+		for field := range fieldErrors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			for _, fieldError := range fieldErrors[field] {
+				sb.WriteString(fmt.Sprintf("; %s: %s", field, fieldError.Message))
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+func (e *RestError) Unwrap() error {
+	if err, ok := errorCodes[e.StatusCode]; ok {
+		return err
+	}
+
+	return ErrUnknown
+}
+
+// Is allows comparing against a JsonErrorCode, e.g. errors.Is(err, request.UnknownMessage)
+func (e *RestError) Is(target error) bool {
+	if code, ok := target.(JsonErrorCode); ok {
+		return e.Code == code
+	}
+
+	return false
+}
+
+// FieldErrors flattens the nested errors object, keyed by the path to the field, e.g. embed.fields.0.name
+func (e *RestError) FieldErrors() map[string][]FieldError {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+
+	var nested map[string]json.RawMessage
+	if err := json.Unmarshal(e.Errors, &nested); err != nil {
+		return nil
+	}
+
+	fieldErrors := make(map[string][]FieldError)
+	flattenFieldErrors("", nested, fieldErrors)
+	return fieldErrors
+}
+
+func flattenFieldErrors(path string, nested map[string]json.RawMessage, fieldErrors map[string][]FieldError) {
+	for key, value := range nested {
+		if key == "_errors" {
+			var errs []FieldError
+			if err := json.Unmarshal(value, &errs); err == nil {
+				fieldErrors[path] = append(fieldErrors[path], errs...)
+			}
+
+			continue
+		}
+
+		childPath := key
+		if path != "" {
+			childPath = path + "." + key
+		}
+
+		var child map[string]json.RawMessage
+		if err := json.Unmarshal(value, &child); err == nil {
+			flattenFieldErrors(childPath, child, fieldErrors)
+		}
+	}
+}
+
+func (c JsonErrorCode) Error() string {
+	return fmt.Sprintf("discord error code %d", int(c))
+}
+
+// IsErrorCode returns whether err is a RestError with the given JSON error code
+func IsErrorCode(err error, code JsonErrorCode) bool {
+	var restError *RestError
+	return errors.As(err, &restError) && restError.Code == code
+}
+
 func IsServerError(err error) bool {
-	_, ok := err.(ServerError)
-	return ok
+	var restError *RestError
+	if errors.As(err, &restError) {
+		return restError.StatusCode >= 500 && restError.StatusCode < 600
+	}
+
+	for status, sentinel := range errorCodes {
+		if status >= 500 && errors.Is(err, sentinel) {
+			return true
+		}
+	}
+
+	return false
 }
 
 func IsClientError(err error) bool {
-	_, ok := err.(ClientError)
-	return ok
+	var restError *RestError
+	if errors.As(err, &restError) {
+		return restError.StatusCode >= 400 && restError.StatusCode < 500
+	}
+
+	for status, sentinel := range errorCodes {
+		if status < 500 && errors.Is(err, sentinel) {
+			return true
+		}
+	}
+
+	return false
 }
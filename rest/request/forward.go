@@ -0,0 +1,41 @@
+package request
+
+import (
+	"context"
+	"github.com/rxdn/gdl/tracing"
+	"io"
+	"net/http"
+)
+
+// Forward sends an already encoded body to Discord, waiting for the ratelimit, and returns the response as is. Unlike
+// Request, non 2xx responses are not converted to errors, so that they can be passed on by a proxy.
+func (e *Endpoint) Forward(ctx context.Context, token string, body io.Reader, opts ...Option) (*ResponseWithContent, error) {
+	options := ApplyOptions(opts...)
+
+	ctx, span := options.Tracer.StartSpan(ctx, tracing.SpanRestRequest,
+		tracing.String(tracing.KeyHttpMethod, string(e.RequestType)),
+		tracing.String(tracing.KeyRoute, e.Route()),
+	)
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, string(e.RequestType), options.BaseUrl+e.Endpoint, body)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if e.ContentType != Nil {
+		req.Header.Set("Content-Type", string(e.ContentType))
+	}
+
+	res, content, err := e.do(ctx, options, token, req)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &ResponseWithContent{
+		Response: res,
+		Content:  content,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package request
+
+// JsonErrorCode is the code sent by Discord in the body of an error response, which is more specific than the HTTP
+// status code. https://discord.com/developers/docs/topics/opcodes-and-status-codes#json
+type JsonErrorCode int
+
+const (
+	GeneralError JsonErrorCode = 0
+
+	UnknownAccount             JsonErrorCode = 10001
+	UnknownApplication         JsonErrorCode = 10002
+	UnknownChannel             JsonErrorCode = 10003
+	UnknownGuild               JsonErrorCode = 10004
+	UnknownIntegration         JsonErrorCode = 10005
+	UnknownInvite              JsonErrorCode = 10006
+	UnknownMember              JsonErrorCode = 10007
+	UnknownMessage             JsonErrorCode = 10008
+	UnknownPermissionOverwrite JsonErrorCode = 10009
+	UnknownProvider            JsonErrorCode = 10010
+	UnknownRole                JsonErrorCode = 10011
+	UnknownToken               JsonErrorCode = 10012
+	UnknownUser                JsonErrorCode = 10013
+	UnknownEmoji               JsonErrorCode = 10014
+	UnknownWebhook             JsonErrorCode = 10015
+	UnknownBan                 JsonErrorCode = 10026
+	UnknownSku                 JsonErrorCode = 10027
+	UnknownStoreListing        JsonErrorCode = 10028
+	UnknownEntitlement         JsonErrorCode = 10029
+	UnknownBuild               JsonErrorCode = 10030
+	UnknownLobby               JsonErrorCode = 10031
+	UnknownBranch              JsonErrorCode = 10032
+	UnknownRedistributable     JsonErrorCode = 10036
+
+	BotsCannotUseEndpoint  JsonErrorCode = 20001
+	OnlyBotsCanUseEndpoint JsonErrorCode = 20002
+
+	MaxGuildsReached        JsonErrorCode = 30001
+	MaxFriendsReached       JsonErrorCode = 30002
+	MaxPinsReached          JsonErrorCode = 30003
+	MaxGuildRolesReached    JsonErrorCode = 30005
+	MaxWebhooksReached      JsonErrorCode = 30007
+	MaxReactionsReached     JsonErrorCode = 30010
+	MaxGuildChannelsReached JsonErrorCode = 30013
+	MaxAttachmentsReached   JsonErrorCode = 30015
+	MaxInvitesReached       JsonErrorCode = 30016
+
+	Unauthorized                JsonErrorCode = 40001
+	AccountVerificationRequired JsonErrorCode = 40002
+	RequestEntityTooLarge       JsonErrorCode = 40005
+	FeatureTemporarilyDisabled  JsonErrorCode = 40006
+	UserBannedFromGuild         JsonErrorCode = 40007
+	MessageAlreadyCrossposted   JsonErrorCode = 40033
+
+	MissingAccess                    JsonErrorCode = 50001
+	InvalidAccountType               JsonErrorCode = 50002
+	CannotExecuteOnDmChannel         JsonErrorCode = 50003
+	GuildWidgetDisabled              JsonErrorCode = 50004
+	CannotEditOtherUsersMessage      JsonErrorCode = 50005
+	CannotSendEmptyMessage           JsonErrorCode = 50006
+	CannotSendMessagesToUser         JsonErrorCode = 50007
+	CannotSendMessagesInVoiceChannel JsonErrorCode = 50008
+	ChannelVerificationTooHigh       JsonErrorCode = 50009
+	OAuth2ApplicationHasNoBot        JsonErrorCode = 50010
+	OAuth2ApplicationLimitReached    JsonErrorCode = 50011
+	InvalidOAuth2State               JsonErrorCode = 50012
+	MissingPermissions               JsonErrorCode = 50013
+	InvalidAuthenticationToken       JsonErrorCode = 50014
+	NoteTooLong                      JsonErrorCode = 50015
+	InvalidBulkDeleteCount           JsonErrorCode = 50016
+	CannotPinMessageInOtherChannel   JsonErrorCode = 50019
+	InvalidInviteCode                JsonErrorCode = 50020
+	CannotExecuteOnSystemMessage     JsonErrorCode = 50021
+	CannotExecuteOnChannelType       JsonErrorCode = 50024
+	InvalidOAuth2AccessToken         JsonErrorCode = 50025
+	InvalidRecipients                JsonErrorCode = 50033
+	MessageTooOldToBulkDelete        JsonErrorCode = 50034
+	InvalidFormBody                  JsonErrorCode = 50035
+	InviteAcceptedToGuildWithoutBot  JsonErrorCode = 50036
+	InvalidApiVersion                JsonErrorCode = 50041
+	CannotDeleteRequiredChannel      JsonErrorCode = 50074
+	InvalidStickerSent               JsonErrorCode = 50081
+
+	ReactionBlocked JsonErrorCode = 90001
+
+	ApiResourceOverloaded JsonErrorCode = 130000
+)
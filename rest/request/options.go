@@ -0,0 +1,127 @@
+package request
+
+import (
+	"context"
+	"github.com/rxdn/gdl/logging"
+	"github.com/rxdn/gdl/metrics"
+	"github.com/rxdn/gdl/tracing"
+	"net/http"
+)
+
+type Options struct {
+	Context     context.Context
+	RetryPolicy *RetryPolicy // overrides the default retry policy
+	HttpClient  *http.Client
+	BaseUrl     string
+	UserAgent   string
+	Hook        func(string) // called with the URL of each request
+	Reason      string       // shown in the audit log
+	Logger      logging.Logger
+	Metrics     metrics.Metrics
+	Tracer      tracing.Tracer
+}
+
+// Option can be passed to any REST function to change how the request is made
+type Option func(*Options)
+
+// WithContext sets the context used for the request, allowing it to be cancelled, and any spans to be children of the
+// span carried by the context
+func WithContext(ctx context.Context) Option {
+	return func(o *Options) {
+		o.Context = ctx
+	}
+}
+
+// WithRetryPolicy overrides the retry policy for a single request
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *Options) {
+		o.RetryPolicy = &policy
+	}
+}
+
+// WithHttpClient sets the client used to send the request, so that connections can be reused
+func WithHttpClient(client *http.Client) Option {
+	return func(o *Options) {
+		o.HttpClient = client
+	}
+}
+
+// WithBaseUrl sends the request to a different URL, such as a proxy
+func WithBaseUrl(baseUrl string) Option {
+	return func(o *Options) {
+		o.BaseUrl = baseUrl
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(o *Options) {
+		o.UserAgent = userAgent
+	}
+}
+
+func WithHook(hook func(string)) Option {
+	return func(o *Options) {
+		o.Hook = hook
+	}
+}
+
+// WithReason sets the reason shown in the audit log for the action, through the X-Audit-Log-Reason header
+func WithReason(reason string) Option {
+	return func(o *Options) {
+		o.Reason = reason
+	}
+}
+
+func WithLogger(logger logging.Logger) Option {
+	return func(o *Options) {
+		o.Logger = logger
+	}
+}
+
+func WithMetrics(metrics metrics.Metrics) Option {
+	return func(o *Options) {
+		o.Metrics = metrics
+	}
+}
+
+func WithTracer(tracer tracing.Tracer) Option {
+	return func(o *Options) {
+		o.Tracer = tracer
+	}
+}
+
+func ApplyOptions(opts ...Option) Options {
+	options := Options{
+		Context:    context.Background(),
+		HttpClient: HttpClient,
+		BaseUrl:    BaseUrl,
+		UserAgent:  DefaultUserAgent,
+		Hook:       Hook,
+		Logger:     Logger,
+		Metrics:    Metrics,
+		Tracer:     Tracer,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.RetryPolicy == nil {
+		policy := Retry
+		options.RetryPolicy = &policy
+	}
+
+	if options.Logger == nil {
+		options.Logger = logging.StandardLogrusLogger()
+	}
+
+	if options.Metrics == nil {
+		options.Metrics = metrics.NoopMetrics{}
+	}
+
+	if options.Tracer == nil {
+		options.Tracer = tracing.NoopTracer{}
+	}
+
+	return options
+}
@@ -9,3 +9,8 @@ const (
 	PUT    RequestType = "PUT"
 	DELETE RequestType = "DELETE"
 )
+
+// IsIdempotent returns whether sending the request multiple times has the same effect as sending it once
+func (r RequestType) IsIdempotent() bool {
+	return r == GET || r == PUT || r == DELETE
+}
@@ -0,0 +1,98 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/url"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. 429s are always retried (up to MaxRateLimitRetries), as
+// Discord has not processed the request. 502, 503, 504 and network errors are only retried for idempotent requests,
+// unless RetryNonIdempotent is set.
+type RetryPolicy struct {
+	MaxRetries          int           // max retries for 5xx and network errors
+	MaxRateLimitRetries int           // max retries for 429s
+	BaseDelay           time.Duration // delay before the first retry, doubled for each attempt after
+	MaxDelay            time.Duration // upper limit for the backoff, and the longest Retry-After we'll wait for
+	RetryNonIdempotent  bool          // retry POST and PATCH requests on 5xx and network errors
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries:          3,
+	MaxRateLimitRetries: 5,
+	BaseDelay:           time.Millisecond * 500,
+	MaxDelay:            time.Second * 30,
+}
+
+// NoRetryPolicy returns all errors straight to the caller
+var NoRetryPolicy = RetryPolicy{}
+
+var retryableStatusCodes = map[int]bool{
+	502: true,
+	503: true,
+	504: true,
+}
+
+// Backoff returns a jittered exponential delay for the given attempt, starting from 0
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << uint(attempt)
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	// full jitter, so that many shards retrying at once don't hit discord at the same time
+	return time.Duration(rand.Int63n(int64(delay)))
+}
+
+// retryDelay returns how long to wait before retrying, and whether the request should be retried at all
+func (p RetryPolicy) retryDelay(requestType RequestType, err error, rateLimitRetries, retries int) (time.Duration, bool, bool) {
+	var restError *RestError
+	if errors.As(err, &restError) {
+		if restError.StatusCode == 429 {
+			if rateLimitRetries >= p.MaxRateLimitRetries || (p.MaxDelay > 0 && restError.RetryAfter > p.MaxDelay) {
+				return 0, false, true
+			}
+
+			// cloudflare 429s don't say how long to wait, so back off rather than retrying straight away
+			if restError.RetryAfter <= 0 {
+				return p.Backoff(rateLimitRetries), true, true
+			}
+
+			return restError.RetryAfter, true, true
+		}
+
+		if !retryableStatusCodes[restError.StatusCode] {
+			return 0, false, false
+		}
+	} else {
+		// client.Do returns a *url.Error for network errors, anything else (e.g. encoding the body) won't succeed if retried
+		var urlError *url.Error
+		if !errors.As(err, &urlError) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false, false
+		}
+	}
+
+	if retries >= p.MaxRetries || !(p.RetryNonIdempotent || requestType.IsIdempotent()) {
+		return 0, false, false
+	}
+
+	return p.Backoff(retries), true, false
+}
+
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,75 @@
+package request
+
+import (
+	"strings"
+)
+
+// Route returns the endpoint with query parameters stripped, and IDs, tokens, invite codes and emojis replaced with
+// placeholders, e.g. /channels/:id/messages/:id
+func (e *Endpoint) Route() string {
+	path := e.Endpoint
+	if index := strings.IndexByte(path, '?'); index != -1 {
+		path = path[:index]
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		var previous string
+		if i > 0 {
+			previous = segments[i-1]
+		}
+
+		if isNumeric(segment) {
+			segments[i] = ":id"
+		} else if previous == "reactions" && segment != "@me" {
+			segments[i] = ":emoji"
+		} else if previous == "invites" {
+			segments[i] = ":code"
+		} else if i > 1 && segments[i-2] == "webhooks" && previous == ":id" {
+			segments[i] = ":token"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// RateLimitRoute returns the method and route, which is used to look up the ratelimit bucket, e.g.
+// GET /channels/:id/messages
+func (e *Endpoint) RateLimitRoute() string {
+	return string(e.RequestType) + " " + e.Route()
+}
+
+// MajorParameter returns the channel, guild or webhook ID in the endpoint. requests to the same route with different
+// major parameters are ratelimited separately
+func (e *Endpoint) MajorParameter() string {
+	path := e.Endpoint
+	if index := strings.IndexByte(path, '?'); index != -1 {
+		path = path[:index]
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) < 2 || !isNumeric(segments[1]) {
+		return ""
+	}
+
+	switch segments[0] {
+	case "channels", "guilds", "webhooks":
+		return segments[1]
+	default:
+		return ""
+	}
+}
+
+func isNumeric(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -12,50 +12,47 @@ import (
 	"strconv"
 )
 
-func GetCurrentUser(token string, rateLimiter *ratelimit.Ratelimiter) (user.User, error) {
+func GetCurrentUser(token string, rateLimiter *ratelimit.Ratelimiter, opts ...request.Option) (user.User, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    "/users/@me",
-		Bucket:      ratelimit.NewUserBucket(0),
 		RateLimiter: rateLimiter,
 	}
 
 	var user user.User
-	err, _ := endpoint.Request(token, nil, &user)
+	err, _ := endpoint.Request(token, nil, &user, opts...)
 	return user, err
 }
 
-func GetUser(token string, rateLimiter *ratelimit.Ratelimiter, userId uint64) (user.User, error) {
+func GetUser(token string, rateLimiter *ratelimit.Ratelimiter, userId uint64, opts ...request.Option) (user.User, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/users/%d", userId),
-		Bucket:      ratelimit.NewUserBucket(userId),
 		RateLimiter: rateLimiter,
 	}
 
 	var user user.User
-	err, _ := endpoint.Request(token, nil, &user)
+	err, _ := endpoint.Request(token, nil, &user, opts...)
 	return user, err
 }
 
 type ModifyUserData struct {
 	Username string `json:"username,omitempty"`
-	Avatar   string `json:"avatar,omitempty"`
+	Avatar   *Image `json:"avatar,omitempty"` // NullImage removes the avatar
 }
 
-func ModifyCurrentUser(token string, rateLimiter *ratelimit.Ratelimiter, data ModifyUserData) (user.User, error) {
+func ModifyCurrentUser(token string, rateLimiter *ratelimit.Ratelimiter, data ModifyUserData, opts ...request.Option) (user.User, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    "/users/@me",
-		Bucket:      ratelimit.NewUserBucket(0),
 		RateLimiter: rateLimiter,
 	}
 
 	var user user.User
-	err, _ := endpoint.Request(token, data, &user)
+	err, _ := endpoint.Request(token, data, &user, opts...)
 	return user, err
 }
 
@@ -84,39 +81,36 @@ func (d *CurrentUserGuildsData) Query() string {
 	return query.Encode()
 }
 
-func GetCurrentUserGuilds(token string, rateLimiter *ratelimit.Ratelimiter, data CurrentUserGuildsData) ([]guild.Guild, error) {
+func GetCurrentUserGuilds(token string, rateLimiter *ratelimit.Ratelimiter, data CurrentUserGuildsData, opts ...request.Option) ([]guild.Guild, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/users/@me/guilds?%s", data.Query()),
-		Bucket:      ratelimit.NewUserBucket(0),
 		RateLimiter: rateLimiter,
 	}
 
 	var guilds []guild.Guild
-	err, _ := endpoint.Request(token, nil, &guilds)
+	err, _ := endpoint.Request(token, nil, &guilds, opts...)
 	return guilds, err
 }
 
-func LeaveGuild(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) error {
+func LeaveGuild(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/users/@me/guilds/%d", guildId),
-		Bucket:      ratelimit.NewUserBucket(0),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func CreateDM(token string, rateLimiter *ratelimit.Ratelimiter, recipientId uint64) (channel.Channel, error) {
+func CreateDM(token string, rateLimiter *ratelimit.Ratelimiter, recipientId uint64, opts ...request.Option) (channel.Channel, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/users/@me/channels"),
-		Bucket:      ratelimit.NewUserBucket(recipientId), // TODO: Investigate whether this takes the recipient ID
 		RateLimiter: rateLimiter,
 	}
 
@@ -125,20 +119,19 @@ func CreateDM(token string, rateLimiter *ratelimit.Ratelimiter, recipientId uint
 	}
 
 	var channel channel.Channel
-	err, _ := endpoint.Request(token, body, &channel)
+	err, _ := endpoint.Request(token, body, &channel, opts...)
 	return channel, err
 }
 
-func GetUserConnections(token string, rateLimiter *ratelimit.Ratelimiter) ([]integration.Connection, error) {
+func GetUserConnections(token string, rateLimiter *ratelimit.Ratelimiter, opts ...request.Option) ([]integration.Connection, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/users/@me/connections"),
-		Bucket:      ratelimit.NewUserBucket(0),
 		RateLimiter: rateLimiter,
 	}
 
 	var connections []integration.Connection
-	err, _ := endpoint.Request(token, nil, &connections)
+	err, _ := endpoint.Request(token, nil, &connections, opts...)
 	return connections, err
 }
@@ -6,15 +6,14 @@ import (
 	"github.com/rxdn/gdl/rest/request"
 )
 
-func ListVoiceRegions(token string) ([]guild.VoiceRegion, error) {
+func ListVoiceRegions(token string, opts ...request.Option) ([]guild.VoiceRegion, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/voice/regions"),
-		Bucket:      "vr",
 	}
 
 	var voiceRegions []guild.VoiceRegion
-	err, _ := endpoint.Request(token, nil, &voiceRegions)
+	err, _ := endpoint.Request(token, nil, &voiceRegions, opts...)
 	return voiceRegions, err
 }
@@ -1,7 +1,6 @@
 package rest
 
 import (
-	"bytes"
 	"fmt"
 	"github.com/rxdn/gdl/objects/channel/embed"
 	"github.com/rxdn/gdl/objects/channel/message"
@@ -9,10 +8,8 @@ import (
 	"github.com/rxdn/gdl/rest/ratelimit"
 	"github.com/rxdn/gdl/rest/request"
 	"io"
-	"mime/multipart"
-	"net/textproto"
+	"net/url"
 	"strconv"
-	"strings"
 )
 
 type WebhookData struct {
@@ -20,74 +17,69 @@ type WebhookData struct {
 	Avatar   string `json:"avatar,omitempty"`
 }
 
-func CreateWebhook(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data WebhookData) (guild.Webhook, error) {
+func CreateWebhook(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, data WebhookData, opts ...request.Option) (guild.Webhook, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.POST,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/channels/%d/webhooks", channelId),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var webhook guild.Webhook
-	err, _ := endpoint.Request(token, data, &webhook)
+	err, _ := endpoint.Request(token, data, &webhook, opts...)
 	return webhook, err
 }
 
-func GetChannelWebhooks(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64) ([]guild.Webhook, error) {
+func GetChannelWebhooks(token string, rateLimiter *ratelimit.Ratelimiter, channelId uint64, opts ...request.Option) ([]guild.Webhook, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/channels/%d/webhooks", channelId),
-		Bucket:      ratelimit.NewChannelBucket(channelId),
 		RateLimiter: rateLimiter,
 	}
 
 	var webhooks []guild.Webhook
-	err, _ := endpoint.Request(token, nil, &webhooks)
+	err, _ := endpoint.Request(token, nil, &webhooks, opts...)
 	return webhooks, err
 }
 
-func GetGuildWebhooks(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64) ([]guild.Webhook, error) {
+func GetGuildWebhooks(token string, rateLimiter *ratelimit.Ratelimiter, guildId uint64, opts ...request.Option) ([]guild.Webhook, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/guilds/%d/webhooks", guildId),
-		Bucket:      ratelimit.NewGuildBucket(guildId),
 		RateLimiter: rateLimiter,
 	}
 
 	var webhooks []guild.Webhook
-	err, _ := endpoint.Request(token, nil, &webhooks)
+	err, _ := endpoint.Request(token, nil, &webhooks, opts...)
 	return webhooks, err
 }
 
-func GetWebhook(token string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64) (guild.Webhook, error) {
+func GetWebhook(token string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64, opts ...request.Option) (guild.Webhook, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/webhooks/%d", webhookId),
-		Bucket:      ratelimit.NewWebhookBucket(webhookId),
 		RateLimiter: rateLimiter,
 	}
 
 	var webhook guild.Webhook
-	err, _ := endpoint.Request(token, nil, &webhook)
+	err, _ := endpoint.Request(token, nil, &webhook, opts...)
 	return webhook, err
 }
 
 // does not return a User object
-func GetWebhookWithToken(webhookToken string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64) (guild.Webhook, error) {
+func GetWebhookWithToken(webhookToken string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64, opts ...request.Option) (guild.Webhook, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.GET,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/webhooks/%d/%s", webhookId, webhookToken),
-		Bucket:      ratelimit.NewWebhookBucket(webhookId),
 		RateLimiter: rateLimiter,
 	}
 
 	var webhook guild.Webhook
-	err, _ := endpoint.Request("", nil, &webhook)
+	err, _ := endpoint.Request("", nil, &webhook, opts...)
 	return webhook, err
 }
 
@@ -97,146 +89,164 @@ type ModifyWebhookData struct {
 	ChannelId uint64 `json:"channel_id,string,omitempty"`
 }
 
-func ModifyWebhook(token string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64, data ModifyWebhookData) (guild.Webhook, error) {
+func ModifyWebhook(token string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64, data ModifyWebhookData, opts ...request.Option) (guild.Webhook, error) {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/webhooks/%d", webhookId),
-		Bucket:      ratelimit.NewWebhookBucket(webhookId),
 		RateLimiter: rateLimiter,
 	}
 
 	var webhook guild.Webhook
-	err, _ := endpoint.Request(token, data, &webhook)
+	err, _ := endpoint.Request(token, data, &webhook, opts...)
 	return webhook, err
 }
 
-func ModifyWebhookWithToken(webhookToken string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64, data WebhookData) error {
+func ModifyWebhookWithToken(webhookToken string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64, data WebhookData, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.PATCH,
 		ContentType: request.ApplicationJson,
 		Endpoint:    fmt.Sprintf("/webhooks/%d/%s", webhookId, webhookToken),
-		Bucket:      ratelimit.NewWebhookBucket(webhookId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request("", data, nil)
+	err, _ := endpoint.Request("", data, nil, opts...)
 	return err
 }
 
-func DeleteWebhook(token string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64) error {
+func DeleteWebhook(token string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/webhooks/%d", webhookId),
-		Bucket:      ratelimit.NewWebhookBucket(webhookId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request(token, nil, nil)
+	err, _ := endpoint.Request(token, nil, nil, opts...)
 	return err
 }
 
-func DeleteWebhookWithToken(webhookToken string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64) error {
+func DeleteWebhookWithToken(webhookToken string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64, opts ...request.Option) error {
 	endpoint := request.Endpoint{
 		RequestType: request.DELETE,
 		ContentType: request.Nil,
 		Endpoint:    fmt.Sprintf("/webhooks/%d/%s", webhookId, webhookToken),
-		Bucket:      ratelimit.NewWebhookBucket(webhookId),
 		RateLimiter: rateLimiter,
 	}
 
-	err, _ := endpoint.Request("", nil, nil)
+	err, _ := endpoint.Request("", nil, nil, opts...)
 	return err
 }
 
 type WebhookBody struct {
-	Content         string                 `json:"content,omitempty"`
-	Username        string                 `json:"username,omitempty"`
-	AvatarUrl       string                 `json:"avatar_url,omitempty"`
-	Tts             bool                   `json:"tts"`
-	File            *File                  `json:"file,omitempty"`
-	Embeds          []*embed.Embed         `json:"embeds,omitempty"`
-	PayloadJson     string                 `json:"payload_json"`
-	AllowedMentions message.AllowedMention `json:"allowed_mentions,omitempty"`
-}
-
-func (d WebhookBody) EncodeMultipartFormData() ([]byte, string, error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-
-	if d.File != nil {
-		fileName := d.File.Name
-		fileName = strings.Replace(fileName, "\\", "\\\\", -1)
-		fileName = strings.Replace(fileName, "\"", "\\\"", -1)
-
-		h := make(textproto.MIMEHeader)
-		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, fileName))
-		h.Set("Content-Type", d.File.ContentType)
-
-		part, err := writer.CreatePart(h)
-		if err != nil {
-			return body.Bytes(), writer.Boundary(), err
-		}
+	Content         string                  `json:"content,omitempty"`
+	Username        string                  `json:"username,omitempty"`
+	AvatarUrl       string                  `json:"avatar_url,omitempty"`
+	Tts             bool                    `json:"tts"`
+	File            *File                   `json:"-"` // deprecated: use Files
+	Files           []*File                 `json:"-"`
+	Embeds          []*embed.Embed          `json:"embeds,omitempty"`           // files can be referenced with File.AttachmentUrl
+	PayloadJson     string                  `json:"-"`                          // overrides the JSON encoded data when uploading files
+	AllowedMentions *message.AllowedMention `json:"allowed_mentions,omitempty"` // defaults to Client.AllowedMentions
+	ThreadId        uint64                  `json:"-"`                          // send the message in a thread in the webhook's channel
+}
 
-		if _, err := io.Copy(part, d.File.Reader); err != nil {
-			return body.Bytes(), writer.Boundary(), err
-		}
-	}
+func (d WebhookBody) query(wait bool) string {
+	query := url.Values{}
+	query.Set("wait", strconv.FormatBool(wait))
 
-	if d.Username != "" {
-		if err := writer.WriteField("username", d.Username); err != nil {
-			return body.Bytes(), writer.Boundary(), err
-		}
+	if d.ThreadId != 0 {
+		query.Set("thread_id", strconv.FormatUint(d.ThreadId, 10))
 	}
 
-	if d.AvatarUrl != "" {
-		if err := writer.WriteField("avatar_url", d.AvatarUrl); err != nil {
-			return body.Bytes(), writer.Boundary(), err
-		}
-	}
+	return query.Encode()
+}
 
-	if err := writer.WriteField("tts", strconv.FormatBool(d.Tts)); err != nil {
-		return body.Bytes(), writer.Boundary(), err
-	}
+func (d WebhookBody) files() []*File {
+	return mergeFiles(d.File, d.Files)
+}
 
-	if d.PayloadJson != "" {
-		if err := writer.WriteField("payload_json", d.PayloadJson); err != nil {
-			return body.Bytes(), writer.Boundary(), err
-		}
-	}
+func (d WebhookBody) EncodeMultipartFormData() (io.Reader, string, error) {
+	return encodeMultipart(d, d.PayloadJson, d.files())
+}
 
-	return []byte(string(body.Bytes()) + "\r\n--" + writer.Boundary() + "--"), writer.Boundary(), nil
+func (d WebhookBody) Rewind() error {
+	return rewindFiles(d.files())
 }
 
 // if wait=true, a message object will be returned
-func ExecuteWebhook(webhookToken string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64, wait bool, data WebhookBody) (*message.Message, error) {
+func ExecuteWebhook(webhookToken string, rateLimiter *ratelimit.Ratelimiter, webhookId uint64, wait bool, data WebhookBody, opts ...request.Option) (*message.Message, error) {
 	var endpoint request.Endpoint
 
-	if data.File == nil {
+	if len(data.files()) == 0 {
 		endpoint = request.Endpoint{
 			RequestType: request.POST,
 			ContentType: request.ApplicationJson,
-			Endpoint:    fmt.Sprintf("/webhooks/%d/%s?wait=%t", webhookId, webhookToken, wait),
-			Bucket:      ratelimit.NewWebhookExecuteBucket(webhookId),
+			Endpoint:    fmt.Sprintf("/webhooks/%d/%s?%s", webhookId, webhookToken, data.query(wait)),
 			RateLimiter: rateLimiter,
 		}
 	} else {
 		endpoint = request.Endpoint{
 			RequestType: request.POST,
 			ContentType: request.MultipartFormData,
-			Endpoint:    fmt.Sprintf("/webhooks/%d/%s?wait=%t", webhookId, webhookToken, wait),
-			Bucket:      ratelimit.NewWebhookExecuteBucket(webhookId),
+			Endpoint:    fmt.Sprintf("/webhooks/%d/%s?%s", webhookId, webhookToken, data.query(wait)),
 			RateLimiter: rateLimiter,
 		}
 
 	}
 	if wait {
 		var message message.Message
-		err, _ := endpoint.Request("", data, &message)
+		err, _ := endpoint.Request("", data, &message, opts...)
 		return &message, err
 	} else {
-		err, _ := endpoint.Request("", data, nil)
+		err, _ := endpoint.Request("", data, nil, opts...)
 		return nil, err
 	}
 }
+
+type WebhookEditBody struct {
+	Content         string                  `json:"content,omitempty"`
+	Embeds          []*embed.Embed          `json:"embeds,omitempty"`
+	Files           []*File                 `json:"-"`
+	PayloadJson     string                  `json:"-"`
+	AllowedMentions *message.AllowedMention `json:"allowed_mentions,omitempty"` // defaults to Client.AllowedMentions
+}
+
+func (d WebhookEditBody) EncodeMultipartFormData() (io.Reader, string, error) {
+	return encodeMultipart(d, d.PayloadJson, d.Files)
+}
+
+func (d WebhookEditBody) Rewind() error {
+	return rewindFiles(d.Files)
+}
+
+// only messages sent by the webhook can be edited
+func EditWebhookMessage(webhookToken string, rateLimiter *ratelimit.Ratelimiter, webhookId, messageId uint64, data WebhookEditBody, opts ...request.Option) (message.Message, error) {
+	endpoint := request.Endpoint{
+		RequestType: request.PATCH,
+		ContentType: request.ApplicationJson,
+		Endpoint:    fmt.Sprintf("/webhooks/%d/%s/messages/%d", webhookId, webhookToken, messageId),
+		RateLimiter: rateLimiter,
+	}
+
+	if len(data.Files) > 0 {
+		endpoint.ContentType = request.MultipartFormData
+	}
+
+	var message message.Message
+	err, _ := endpoint.Request("", data, &message, opts...)
+	return message, err
+}
+
+// only messages sent by the webhook can be deleted
+func DeleteWebhookMessage(webhookToken string, rateLimiter *ratelimit.Ratelimiter, webhookId, messageId uint64, opts ...request.Option) error {
+	endpoint := request.Endpoint{
+		RequestType: request.DELETE,
+		ContentType: request.Nil,
+		Endpoint:    fmt.Sprintf("/webhooks/%d/%s/messages/%d", webhookId, webhookToken, messageId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ := endpoint.Request("", nil, nil, opts...)
+	return err
+}
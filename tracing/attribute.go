@@ -0,0 +1,36 @@
+package tracing
+
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Int64(key string, value int64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+const (
+	KeyHttpMethod     = "http.method"
+	KeyHttpStatusCode = "http.status_code"
+	KeyRoute          = "gdl.route"
+	KeyBucket         = "gdl.bucket"
+	KeyShardId        = "gdl.shard_id"
+	KeyEvent          = "gdl.event"
+	KeyListener       = "gdl.listener"
+	KeyCacheResource  = "gdl.cache.resource"
+	KeyCacheHit       = "gdl.cache.hit"
+	KeyCommand        = "gdl.command"
+	KeyRetries        = "gdl.retries"
+)
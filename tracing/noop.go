@@ -0,0 +1,16 @@
+package tracing
+
+import "context"
+
+// NoopTracer does not record any spans, and is used when no Tracer is provided
+type NoopTracer struct{}
+
+func (NoopTracer) StartSpan(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, NoopSpan{}
+}
+
+type NoopSpan struct{}
+
+func (NoopSpan) SetAttributes(...Attribute) {}
+func (NoopSpan) RecordError(error)          {}
+func (NoopSpan) End()                       {}
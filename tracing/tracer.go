@@ -0,0 +1,25 @@
+package tracing
+
+import "context"
+
+// Tracer is a hook for tracing libraries, such as OpenTelemetry. StartSpan should return a context carrying the new
+// span, so that spans started with it become children.
+type Tracer interface {
+	StartSpan(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+}
+
+type Span interface {
+	SetAttributes(attributes ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+const (
+	SpanRestRequest   = "gdl.rest.request"
+	SpanRestHttp      = "gdl.rest.http"
+	SpanRateLimitWait = "gdl.ratelimit.wait"
+	SpanCacheLookup   = "gdl.cache.lookup"
+	SpanEvent         = "gdl.gateway.event"
+	SpanListener      = "gdl.gateway.listener"
+	SpanCommand       = "gdl.command"
+)
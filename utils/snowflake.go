@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Snowflake is a Discord ID, which is sent as a string, but may also be decoded from a number.
+// https://discord.com/developers/docs/reference#snowflakes
+type Snowflake uint64
+
+var snowflakeType = reflect.TypeOf(Snowflake(0))
+
+// NewSnowflake returns the lowest possible ID generated at t, which can be used as a pagination cursor
+func NewSnowflake(t time.Time) Snowflake {
+	return Snowflake(TimeToSnowflake(t))
+}
+
+// ParseSnowflake parses an ID from its decimal string form
+func ParseSnowflake(str string) (Snowflake, error) {
+	id, err := strconv.ParseUint(str, 10, 64)
+	return Snowflake(id), err
+}
+
+// Time returns the time the ID was generated
+func (s Snowflake) Time() time.Time {
+	return SnowflakeToTime(uint64(s))
+}
+
+// Timestamp returns the milliseconds since DiscordEpoch, which is also used to calculate the shard for a guild
+func (s Snowflake) Timestamp() uint64 {
+	return uint64(s) >> 22
+}
+
+func (s Snowflake) WorkerID() uint64 {
+	return (uint64(s) & 0x3E0000) >> 17
+}
+
+func (s Snowflake) ProcessID() uint64 {
+	return (uint64(s) & 0x1F000) >> 12
+}
+
+// Increment is incremented for every ID generated on the process, within the same millisecond
+func (s Snowflake) Increment() uint64 {
+	return uint64(s) & 0xFFF
+}
+
+func (s Snowflake) Uint64() uint64 {
+	return uint64(s)
+}
+
+func (s Snowflake) String() string {
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+func (s Snowflake) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(s.String())), nil
+}
+
+func (s *Snowflake) UnmarshalJSON(b []byte) error {
+	str := string(b)
+	if str == "null" {
+		return nil
+	}
+
+	// ids are sent as strings, but may be sent as numbers
+	if unquoted, err := strconv.Unquote(str); err == nil {
+		str = unquoted
+	}
+
+	if str == "" {
+		*s = 0
+		return nil
+	}
+
+	id, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return &json.UnmarshalTypeError{Value: string(b), Type: snowflakeType}
+	}
+
+	*s = Snowflake(id)
+	return nil
+}
+
+// SnowflakeGenerator mints unique IDs locally, e.g. for tests
+type SnowflakeGenerator struct {
+	WorkerId  uint64 // 5 bits
+	ProcessId uint64 // 5 bits
+
+	mu        sync.Mutex
+	lastMilli int64
+	increment uint64
+}
+
+func NewSnowflakeGenerator(workerId, processId uint64) *SnowflakeGenerator {
+	return &SnowflakeGenerator{
+		WorkerId:  workerId & 0x1F,
+		ProcessId: processId & 0x1F,
+	}
+}
+
+// Next returns a new ID for the current time. if more than 4096 IDs are generated in a millisecond, the timestamp is
+// advanced, so that IDs remain unique and increasing
+func (g *SnowflakeGenerator) Next() Snowflake {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	millis := time.Now().UnixNano()/int64(time.Millisecond) - DiscordEpoch
+	if millis < 0 {
+		millis = 0
+	}
+
+	if millis <= g.lastMilli {
+		g.increment++
+		if g.increment > 0xFFF {
+			g.lastMilli++
+			g.increment = 0
+		}
+	} else {
+		g.lastMilli = millis
+		g.increment = 0
+	}
+
+	return Snowflake(uint64(g.lastMilli)<<22 | (g.WorkerId&0x1F)<<17 | (g.ProcessId&0x1F)<<12 | g.increment)
+}
@@ -5,3 +5,22 @@ import "time"
 func GetCurrentTimeMillis() int64 {
 	return time.Now().UnixNano() / int64(time.Millisecond)
 }
+
+// DiscordEpoch is the first millisecond of 2015, which snowflake timestamps are relative to
+const DiscordEpoch int64 = 1420070400000
+
+// SnowflakeToTime returns the time an ID was generated
+func SnowflakeToTime(id uint64) time.Time {
+	millis := int64(id>>22) + DiscordEpoch
+	return time.Unix(0, millis*int64(time.Millisecond))
+}
+
+// TimeToSnowflake returns the lowest possible ID generated at t, which can be used as a pagination cursor
+func TimeToSnowflake(t time.Time) uint64 {
+	millis := t.UnixNano()/int64(time.Millisecond) - DiscordEpoch
+	if millis < 0 {
+		return 0
+	}
+
+	return uint64(millis) << 22
+}
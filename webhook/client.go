@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"errors"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/objects/guild"
+	"github.com/rxdn/gdl/rest"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"github.com/rxdn/gdl/rest/request"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidUrl = errors.New("invalid webhook url")
+
+// Client executes a single webhook, without needing a bot token. Each client has its own ratelimiter, so clients should
+// be reused rather than created for each message.
+type Client struct {
+	Id         uint64
+	Token      string
+	RestClient *rest.Client
+}
+
+func NewClient(id uint64, token string) *Client {
+	rateLimiter := ratelimit.NewRateLimiter(ratelimit.NewMemoryStore(), 1)
+
+	return &Client{
+		Id:         id,
+		Token:      token,
+		RestClient: rest.NewClient("", rateLimiter),
+	}
+}
+
+// NewClientFromUrl parses the ID and token from a webhook URL, e.g. https://discord.com/api/webhooks/id/token
+func NewClientFromUrl(webhookUrl string) (*Client, error) {
+	id, token, err := ParseUrl(webhookUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(id, token), nil
+}
+
+// ParseUrl returns the ID and token from a webhook URL
+func ParseUrl(webhookUrl string) (uint64, string, error) {
+	parsed, err := url.Parse(webhookUrl)
+	if err != nil {
+		return 0, "", ErrInvalidUrl
+	}
+
+	// the path may or may not contain an API version, e.g. /api/v6/webhooks/id/token
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	for i, segment := range segments {
+		if segment != "webhooks" || i+2 >= len(segments) {
+			continue
+		}
+
+		id, err := strconv.ParseUint(segments[i+1], 10, 64)
+		if err != nil || segments[i+2] == "" {
+			return 0, "", ErrInvalidUrl
+		}
+
+		return id, segments[i+2], nil
+	}
+
+	return 0, "", ErrInvalidUrl
+}
+
+// Execute sends a message without waiting for it to be created
+func (c *Client) Execute(data rest.WebhookBody, opts ...request.Option) error {
+	_, err := c.RestClient.ExecuteWebhook(c.Id, c.Token, false, data, opts...)
+	return err
+}
+
+// ExecuteAndWait sends a message, returning it once it has been created
+func (c *Client) ExecuteAndWait(data rest.WebhookBody, opts ...request.Option) (message.Message, error) {
+	msg, err := c.RestClient.ExecuteWebhook(c.Id, c.Token, true, data, opts...)
+	if err != nil || msg == nil {
+		return message.Message{}, err
+	}
+
+	return *msg, nil
+}
+
+// EditMessage edits a message that was sent by the webhook
+func (c *Client) EditMessage(messageId uint64, data rest.WebhookEditBody, opts ...request.Option) (message.Message, error) {
+	return c.RestClient.EditWebhookMessage(c.Id, c.Token, messageId, data, opts...)
+}
+
+// DeleteMessage deletes a message that was sent by the webhook
+func (c *Client) DeleteMessage(messageId uint64, opts ...request.Option) error {
+	return c.RestClient.DeleteWebhookMessage(c.Id, c.Token, messageId, opts...)
+}
+
+// Get returns the webhook. the User field is not returned when using a webhook token
+func (c *Client) Get(opts ...request.Option) (guild.Webhook, error) {
+	return c.RestClient.GetWebhookWithToken(c.Id, c.Token, opts...)
+}
@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// LogrusHook sends log entries to a Sink
+type LogrusHook struct {
+	Sink      *Sink
+	Formatter logrus.Formatter
+	levels    []logrus.Level
+}
+
+var _ logrus.Hook = (*LogrusHook)(nil)
+
+// NewLogrusHook sends entries of the given levels to the sink. if no levels are given, warnings and above are sent
+func NewLogrusHook(sink *Sink, levels ...logrus.Level) *LogrusHook {
+	if len(levels) == 0 {
+		levels = []logrus.Level{logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel, logrus.WarnLevel}
+	}
+
+	return &LogrusHook{
+		Sink: sink,
+		Formatter: &logrus.TextFormatter{
+			DisableColors:    true,
+			DisableTimestamp: true,
+		},
+		levels: levels,
+	}
+}
+
+func (h *LogrusHook) Levels() []logrus.Level {
+	return h.levels
+}
+
+func (h *LogrusHook) Fire(entry *logrus.Entry) error {
+	line, err := h.Formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.Sink.Write(line)
+	return err
+}
@@ -0,0 +1,279 @@
+package webhook
+
+import (
+	"fmt"
+	"github.com/rxdn/gdl/logging"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/rest"
+	"github.com/rxdn/gdl/rest/request"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+type SinkOptions struct {
+	FlushInterval   time.Duration // defaults to 2 seconds
+	MaxQueued       int           // lines and embeds are dropped once this many are waiting to be sent. defaults to 1000
+	Username        string
+	AvatarUrl       string
+	OnError         func(error)             // called when sending a batch fails
+	AllowedMentions *message.AllowedMention // defaults to none, as log lines often contain user provided text
+}
+
+// Sink buffers lines and embeds, sending them through a webhook in as few messages as possible. Consecutive duplicate
+// lines are merged, and if the webhook can't keep up, new lines are dropped and a count of dropped lines is sent instead.
+type Sink struct {
+	client  *Client
+	options SinkOptions
+
+	mu      sync.Mutex
+	lines   []string
+	embeds  []*embed.Embed
+	dropped int
+	closed  bool
+
+	sendLock  sync.Mutex
+	flushCh   chan struct{}
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	doneCh    chan struct{}
+}
+
+func NewSink(client *Client, options SinkOptions) *Sink {
+	if options.FlushInterval <= 0 {
+		options.FlushInterval = time.Second * 2
+	}
+
+	if options.MaxQueued <= 0 {
+		options.MaxQueued = 1000
+	}
+
+	if options.AllowedMentions == nil {
+		options.AllowedMentions = message.NewAllowedMentions()
+	}
+
+	sink := &Sink{
+		client:  client,
+		options: options,
+		flushCh: make(chan struct{}, 1),
+		closeCh: make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+
+	go sink.run()
+	return sink
+}
+
+// Write queues each line in p, so that the sink can be used as an io.Writer, e.g. for the standard library logger
+func (s *Sink) Write(p []byte) (int, error) {
+	for _, line := range strings.Split(string(p), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if line != "" {
+			s.Send(line)
+		}
+	}
+
+	return len(p), nil
+}
+
+// Send queues a line. lines sent after Close are discarded
+func (s *Sink) Send(line string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	if s.queued() >= s.options.MaxQueued {
+		s.dropped++
+		return
+	}
+
+	s.lines = append(s.lines, line)
+	s.notifyIfFull()
+}
+
+func (s *Sink) SendEmbed(e *embed.Embed) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	if s.queued() >= s.options.MaxQueued {
+		s.dropped++
+		return
+	}
+
+	s.embeds = append(s.embeds, e)
+	s.notifyIfFull()
+}
+
+// Flush sends everything that is queued, blocking until it has been sent
+func (s *Sink) Flush() {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+
+	s.mu.Lock()
+	lines, embeds, dropped := s.lines, s.embeds, s.dropped
+	s.lines, s.embeds, s.dropped = nil, nil, 0
+	s.mu.Unlock()
+
+	if dropped > 0 {
+		lines = append(lines, fmt.Sprintf("... %d lines dropped", dropped))
+	}
+
+	for _, body := range s.batch(lines, embeds) {
+		// the sink is often fed by the logger, so the client's own warnings, e.g. when it hits a ratelimit, mustn't
+		// be logged, or they would be queued again
+		if err := s.client.Execute(body, request.WithLogger(logging.NoopLogger{})); err != nil && s.options.OnError != nil {
+			s.options.OnError(err)
+		}
+	}
+}
+
+// Close flushes any queued lines and stops the sink. it is safe to call more than once
+func (s *Sink) Close() error {
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+
+		close(s.closeCh)
+	})
+
+	<-s.doneCh
+	return nil
+}
+
+func (s *Sink) run() {
+	ticker := time.NewTicker(s.options.FlushInterval)
+	defer ticker.Stop()
+	defer close(s.doneCh)
+
+	for {
+		select {
+		case <-ticker.C:
+			s.Flush()
+		case <-s.flushCh:
+			s.Flush()
+		case <-s.closeCh:
+			s.Flush()
+			return
+		}
+	}
+}
+
+// mu must be held
+func (s *Sink) queued() int {
+	return len(s.lines) + len(s.embeds)
+}
+
+// flush early once a full message is waiting, rather than waiting for the interval. mu must be held
+func (s *Sink) notifyIfFull() {
+	length := 0
+	for _, line := range s.lines {
+		length += utf8.RuneCountInString(line) + 1
+	}
+
+	if length >= message.MaxContentLength || len(s.embeds) >= message.MaxEmbeds {
+		select {
+		case s.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *Sink) batch(lines []string, embeds []*embed.Embed) []rest.WebhookBody {
+	var bodies []rest.WebhookBody
+	for _, content := range packLines(mergeDuplicates(lines)) {
+		bodies = append(bodies, s.newBody(content))
+	}
+
+	// attach embeds to the messages we're already sending, before sending extra messages
+	for i := 0; len(embeds) > 0; i++ {
+		if i == len(bodies) {
+			bodies = append(bodies, s.newBody(""))
+		}
+
+		count := message.MaxEmbeds
+		if len(embeds) < count {
+			count = len(embeds)
+		}
+
+		bodies[i].Embeds = embeds[:count]
+		embeds = embeds[count:]
+	}
+
+	return bodies
+}
+
+func (s *Sink) newBody(content string) rest.WebhookBody {
+	return rest.WebhookBody{
+		Content:         content,
+		Username:        s.options.Username,
+		AvatarUrl:       s.options.AvatarUrl,
+		AllowedMentions: s.options.AllowedMentions,
+	}
+}
+
+// mergeDuplicates collapses consecutive identical lines into one, e.g. "error (x3)"
+func mergeDuplicates(lines []string) []string {
+	var merged []string
+	for i := 0; i < len(lines); {
+		j := i + 1
+		for j < len(lines) && lines[j] == lines[i] {
+			j++
+		}
+
+		if count := j - i; count > 1 {
+			merged = append(merged, fmt.Sprintf("%s (x%d)", lines[i], count))
+		} else {
+			merged = append(merged, lines[i])
+		}
+
+		i = j
+	}
+
+	return merged
+}
+
+// packLines joins lines into as few messages as possible, truncating lines that are too long for a single message
+func packLines(lines []string) []string {
+	var messages []string
+	var current strings.Builder
+	currentLength := 0
+
+	for _, line := range lines {
+		lineLength := utf8.RuneCountInString(line)
+		if lineLength > message.MaxContentLength {
+			line = string([]rune(line)[:message.MaxContentLength-3]) + "..."
+			lineLength = message.MaxContentLength
+		}
+
+		// +1 for the newline
+		if currentLength > 0 && currentLength+1+lineLength > message.MaxContentLength {
+			messages = append(messages, current.String())
+			current.Reset()
+			currentLength = 0
+		}
+
+		if currentLength > 0 {
+			current.WriteByte('\n')
+			currentLength++
+		}
+
+		current.WriteString(line)
+		currentLength += lineLength
+	}
+
+	if currentLength > 0 {
+		messages = append(messages, current.String())
+	}
+
+	return messages
+}